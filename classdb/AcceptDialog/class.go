@@ -29,6 +29,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -378,16 +379,16 @@ func (self class) GetOkButtonText() String.Readable { //gd:AcceptDialog.get_ok_b
 	frame.Free()
 	return ret
 }
-func (self Instance) OnConfirmed(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("confirmed"), gd.NewCallable(cb), 0)
+func (self Instance) OnConfirmed(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "confirmed", cb, options...)
 }
 
-func (self Instance) OnCanceled(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("canceled"), gd.NewCallable(cb), 0)
+func (self Instance) OnCanceled(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "canceled", cb, options...)
 }
 
-func (self Instance) OnCustomAction(cb func(action string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("custom_action"), gd.NewCallable(cb), 0)
+func (self Instance) OnCustomAction(cb func(action string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "custom_action", cb, options...)
 }
 
 func (self class) AsAcceptDialog() Advanced          { return *((*Advanced)(unsafe.Pointer(&self))) }
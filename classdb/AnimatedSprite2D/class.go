@@ -28,6 +28,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -549,24 +550,24 @@ func (self class) GetPlayingSpeed() float64 { //gd:AnimatedSprite2D.get_playing_
 	frame.Free()
 	return ret
 }
-func (self Instance) OnSpriteFramesChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("sprite_frames_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnSpriteFramesChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "sprite_frames_changed", cb, options...)
 }
 
-func (self Instance) OnAnimationChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_changed", cb, options...)
 }
 
-func (self Instance) OnFrameChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("frame_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnFrameChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "frame_changed", cb, options...)
 }
 
-func (self Instance) OnAnimationLooped(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_looped"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationLooped(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_looped", cb, options...)
 }
 
-func (self Instance) OnAnimationFinished(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_finished"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationFinished(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_finished", cb, options...)
 }
 
 func (self class) AsAnimatedSprite2D() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -240,20 +241,20 @@ func (self class) GetAnimationListSize() int64 { //gd:AnimationLibrary.get_anima
 	frame.Free()
 	return ret
 }
-func (self Instance) OnAnimationAdded(cb func(name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_added"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationAdded(cb func(name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_added", cb, options...)
 }
 
-func (self Instance) OnAnimationRemoved(cb func(name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_removed"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationRemoved(cb func(name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_removed", cb, options...)
 }
 
-func (self Instance) OnAnimationRenamed(cb func(name string, to_name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_renamed"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationRenamed(cb func(name string, to_name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_renamed", cb, options...)
 }
 
-func (self Instance) OnAnimationChanged(cb func(name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationChanged(cb func(name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_changed", cb, options...)
 }
 
 func (self class) AsAnimationLibrary() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -29,6 +29,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector3"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1121,32 +1122,32 @@ func (self class) FindAnimationLibrary(animation [1]gdclass.Animation) String.Na
 	frame.Free()
 	return ret
 }
-func (self Instance) OnAnimationListChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_list_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationListChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_list_changed", cb, options...)
 }
 
-func (self Instance) OnAnimationLibrariesUpdated(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_libraries_updated"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationLibrariesUpdated(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_libraries_updated", cb, options...)
 }
 
-func (self Instance) OnAnimationFinished(cb func(anim_name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_finished"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationFinished(cb func(anim_name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_finished", cb, options...)
 }
 
-func (self Instance) OnAnimationStarted(cb func(anim_name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_started"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationStarted(cb func(anim_name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_started", cb, options...)
 }
 
-func (self Instance) OnCachesCleared(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("caches_cleared"), gd.NewCallable(cb), 0)
+func (self Instance) OnCachesCleared(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "caches_cleared", cb, options...)
 }
 
-func (self Instance) OnMixerApplied(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("mixer_applied"), gd.NewCallable(cb), 0)
+func (self Instance) OnMixerApplied(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "mixer_applied", cb, options...)
 }
 
-func (self Instance) OnMixerUpdated(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("mixer_updated"), gd.NewCallable(cb), 0)
+func (self Instance) OnMixerUpdated(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "mixer_updated", cb, options...)
 }
 
 func (self class) AsAnimationMixer() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -776,16 +777,16 @@ func (self class) GetParameter(name String.Name) variant.Any { //gd:AnimationNod
 	frame.Free()
 	return ret
 }
-func (self Instance) OnTreeChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tree_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTreeChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tree_changed", cb, options...)
 }
 
-func (self Instance) OnAnimationNodeRenamed(cb func(object_id int, old_name string, new_name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_node_renamed"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationNodeRenamed(cb func(object_id int, old_name string, new_name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_node_renamed", cb, options...)
 }
 
-func (self Instance) OnAnimationNodeRemoved(cb func(object_id int, name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_node_removed"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationNodeRemoved(cb func(object_id int, name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_node_removed", cb, options...)
 }
 
 func (self class) AsAnimationNode() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
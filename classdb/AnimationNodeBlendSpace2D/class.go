@@ -27,6 +27,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -558,8 +559,8 @@ func (self class) IsUsingSync() bool { //gd:AnimationNodeBlendSpace2D.is_using_s
 	frame.Free()
 	return ret
 }
-func (self Instance) OnTrianglesUpdated(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("triangles_updated"), gd.NewCallable(cb), 0)
+func (self Instance) OnTrianglesUpdated(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "triangles_updated", cb, options...)
 }
 
 func (self class) AsAnimationNodeBlendSpace2D() Advanced {
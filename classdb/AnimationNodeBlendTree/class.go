@@ -27,6 +27,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -320,8 +321,8 @@ func (self class) GetGraphOffset() Vector2.XY { //gd:AnimationNodeBlendTree.get_
 	frame.Free()
 	return ret
 }
-func (self Instance) OnNodeChanged(cb func(node_name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("node_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnNodeChanged(cb func(node_name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "node_changed", cb, options...)
 }
 
 func (self class) AsAnimationNodeBlendTree() Advanced { return *((*Advanced)(unsafe.Pointer(&self))) }
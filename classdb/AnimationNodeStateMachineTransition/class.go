@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -341,8 +342,8 @@ func (self class) GetAdvanceExpression() String.Readable { //gd:AnimationNodeSta
 	frame.Free()
 	return ret
 }
-func (self Instance) OnAdvanceConditionChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("advance_condition_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnAdvanceConditionChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "advance_condition_changed", cb, options...)
 }
 
 func (self class) AsAnimationNodeStateMachineTransition() Advanced {
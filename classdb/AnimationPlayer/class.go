@@ -26,6 +26,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1179,12 +1180,12 @@ func (self class) GetRoot() Path.ToNode { //gd:AnimationPlayer.get_root
 	frame.Free()
 	return ret
 }
-func (self Instance) OnCurrentAnimationChanged(cb func(name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("current_animation_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnCurrentAnimationChanged(cb func(name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "current_animation_changed", cb, options...)
 }
 
-func (self Instance) OnAnimationChanged(cb func(old_name string, new_name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationChanged(cb func(old_name string, new_name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_changed", cb, options...)
 }
 
 func (self class) AsAnimationPlayer() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -26,6 +26,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -218,8 +219,8 @@ func (self class) GetProcessCallback() AnimationProcessCallback { //gd:Animation
 	frame.Free()
 	return ret
 }
-func (self Instance) OnAnimationPlayerChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("animation_player_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnAnimationPlayerChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "animation_player_changed", cb, options...)
 }
 
 func (self class) AsAnimationTree() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -28,6 +28,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1212,12 +1213,14 @@ func (self class) RegisterStreamAsSample(stream [1]gdclass.AudioStream) { //gd:A
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.AudioServer.Bind_register_stream_as_sample, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func OnBusLayoutChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("bus_layout_changed"), gd.NewCallable(cb), 0)
+func OnBusLayoutChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "bus_layout_changed", cb, options...)
 }
 
-func OnBusRenamed(cb func(bus_index int, old_name string, new_name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("bus_renamed"), gd.NewCallable(cb), 0)
+func OnBusRenamed(cb func(bus_index int, old_name string, new_name string), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "bus_renamed", cb, options...)
 }
 
 func (self class) Virtual(name string) reflect.Value {
@@ -26,6 +26,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -489,8 +490,8 @@ func (self class) IsMetaStream() bool { //gd:AudioStream.is_meta_stream
 	frame.Free()
 	return ret
 }
-func (self Instance) OnParameterListChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("parameter_list_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnParameterListChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "parameter_list_changed", cb, options...)
 }
 
 func (self class) AsAudioStream() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
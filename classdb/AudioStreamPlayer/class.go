@@ -27,6 +27,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -526,8 +527,8 @@ func (self class) GetPlaybackType() AudioServer.PlaybackType { //gd:AudioStreamP
 	frame.Free()
 	return ret
 }
-func (self Instance) OnFinished(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("finished"), gd.NewCallable(cb), 0)
+func (self Instance) OnFinished(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "finished", cb, options...)
 }
 
 func (self class) AsAudioStreamPlayer() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -28,6 +28,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -480,20 +481,20 @@ func (self class) GetButtonGroup() [1]gdclass.ButtonGroup { //gd:BaseButton.get_
 	frame.Free()
 	return ret
 }
-func (self Instance) OnPressed(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("pressed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPressed(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "pressed", cb, options...)
 }
 
-func (self Instance) OnButtonUp(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("button_up"), gd.NewCallable(cb), 0)
+func (self Instance) OnButtonUp(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "button_up", cb, options...)
 }
 
-func (self Instance) OnButtonDown(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("button_down"), gd.NewCallable(cb), 0)
+func (self Instance) OnButtonDown(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "button_down", cb, options...)
 }
 
-func (self Instance) OnToggled(cb func(toggled_on bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("toggled"), gd.NewCallable(cb), 0)
+func (self Instance) OnToggled(cb func(toggled_on bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "toggled", cb, options...)
 }
 
 func (self class) AsBaseButton() Advanced              { return *((*Advanced)(unsafe.Pointer(&self))) }
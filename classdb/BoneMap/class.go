@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -193,12 +194,12 @@ func (self class) FindProfileBoneName(skeleton_bone_name String.Name) String.Nam
 	frame.Free()
 	return ret
 }
-func (self Instance) OnBoneMapUpdated(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("bone_map_updated"), gd.NewCallable(cb), 0)
+func (self Instance) OnBoneMapUpdated(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "bone_map_updated", cb, options...)
 }
 
-func (self Instance) OnProfileUpdated(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("profile_updated"), gd.NewCallable(cb), 0)
+func (self Instance) OnProfileUpdated(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "profile_updated", cb, options...)
 }
 
 func (self class) AsBoneMap() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -171,8 +172,8 @@ func (self class) IsAllowUnpress() bool { //gd:ButtonGroup.is_allow_unpress
 	frame.Free()
 	return ret
 }
-func (self Instance) OnPressed(cb func(button BaseButton.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("pressed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPressed(cb func(button BaseButton.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "pressed", cb, options...)
 }
 
 func (self class) AsButtonGroup() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
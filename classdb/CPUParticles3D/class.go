@@ -33,6 +33,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector3"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1661,8 +1662,8 @@ func (self class) ConvertFromParticles(particles [1]gdclass.Node) { //gd:CPUPart
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.CPUParticles3D.Bind_convert_from_particles, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnFinished(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("finished"), gd.NewCallable(cb), 0)
+func (self Instance) OnFinished(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "finished", cb, options...)
 }
 
 func (self class) AsCPUParticles3D() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
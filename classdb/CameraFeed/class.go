@@ -25,6 +25,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Transform2D"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -450,12 +451,12 @@ func (self class) SetFormat(index int64, parameters Dictionary.Any) bool { //gd:
 	frame.Free()
 	return ret
 }
-func (self Instance) OnFrameChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("frame_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnFrameChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "frame_changed", cb, options...)
 }
 
-func (self Instance) OnFormatChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("format_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnFormatChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "format_changed", cb, options...)
 }
 
 func (self class) AsCameraFeed() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -198,12 +199,14 @@ func (self class) RemoveFeed(feed [1]gdclass.CameraFeed) { //gd:CameraServer.rem
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.CameraServer.Bind_remove_feed, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func OnCameraFeedAdded(cb func(id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("camera_feed_added"), gd.NewCallable(cb), 0)
+func OnCameraFeedAdded(cb func(id int), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "camera_feed_added", cb, options...)
 }
 
-func OnCameraFeedRemoved(cb func(id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("camera_feed_removed"), gd.NewCallable(cb), 0)
+func OnCameraFeedRemoved(cb func(id int), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "camera_feed_removed", cb, options...)
 }
 
 func (self class) Virtual(name string) reflect.Value {
@@ -38,6 +38,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Transform2D"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -2181,20 +2182,20 @@ func (self class) GetClipChildrenMode() ClipChildrenMode { //gd:CanvasItem.get_c
 	frame.Free()
 	return ret
 }
-func (self Instance) OnDraw(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("draw"), gd.NewCallable(cb), 0)
+func (self Instance) OnDraw(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "draw", cb, options...)
 }
 
-func (self Instance) OnVisibilityChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("visibility_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnVisibilityChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "visibility_changed", cb, options...)
 }
 
-func (self Instance) OnHidden(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("hidden"), gd.NewCallable(cb), 0)
+func (self Instance) OnHidden(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "hidden", cb, options...)
 }
 
-func (self Instance) OnItemRectChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("item_rect_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnItemRectChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "item_rect_changed", cb, options...)
 }
 
 func (self class) AsCanvasItem() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
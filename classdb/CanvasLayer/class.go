@@ -26,6 +26,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Transform2D"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -419,8 +420,8 @@ func (self class) GetCanvas() RID.Any { //gd:CanvasLayer.get_canvas
 	frame.Free()
 	return ret
 }
-func (self Instance) OnVisibilityChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("visibility_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnVisibilityChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "visibility_changed", cb, options...)
 }
 
 func (self class) AsCanvasLayer() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
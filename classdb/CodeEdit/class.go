@@ -30,6 +30,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -2239,24 +2240,24 @@ func (self class) DuplicateLines() { //gd:CodeEdit.duplicate_lines
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.CodeEdit.Bind_duplicate_lines, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnBreakpointToggled(cb func(line int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("breakpoint_toggled"), gd.NewCallable(cb), 0)
+func (self Instance) OnBreakpointToggled(cb func(line int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "breakpoint_toggled", cb, options...)
 }
 
-func (self Instance) OnCodeCompletionRequested(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("code_completion_requested"), gd.NewCallable(cb), 0)
+func (self Instance) OnCodeCompletionRequested(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "code_completion_requested", cb, options...)
 }
 
-func (self Instance) OnSymbolLookup(cb func(symbol string, line int, column int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("symbol_lookup"), gd.NewCallable(cb), 0)
+func (self Instance) OnSymbolLookup(cb func(symbol string, line int, column int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "symbol_lookup", cb, options...)
 }
 
-func (self Instance) OnSymbolValidate(cb func(symbol string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("symbol_validate"), gd.NewCallable(cb), 0)
+func (self Instance) OnSymbolValidate(cb func(symbol string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "symbol_validate", cb, options...)
 }
 
-func (self Instance) OnSymbolHovered(cb func(symbol string, line int, column int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("symbol_hovered"), gd.NewCallable(cb), 0)
+func (self Instance) OnSymbolHovered(cb func(symbol string, line int, column int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "symbol_hovered", cb, options...)
 }
 
 func (self class) AsCodeEdit() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -30,6 +30,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Transform3D"
 import "graphics.gd/variant/Vector3"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -776,16 +777,16 @@ func (self class) ShapeFindOwner(shape_index int64) int64 { //gd:CollisionObject
 	frame.Free()
 	return ret
 }
-func (self Instance) OnInputEvent(cb func(camera Node.Instance, event InputEvent.Instance, event_position Vector3.XYZ, normal Vector3.XYZ, shape_idx int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("input_event"), gd.NewCallable(cb), 0)
+func (self Instance) OnInputEvent(cb func(camera Node.Instance, event InputEvent.Instance, event_position Vector3.XYZ, normal Vector3.XYZ, shape_idx int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "input_event", cb, options...)
 }
 
-func (self Instance) OnMouseEntered(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("mouse_entered"), gd.NewCallable(cb), 0)
+func (self Instance) OnMouseEntered(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "mouse_entered", cb, options...)
 }
 
-func (self Instance) OnMouseExited(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("mouse_exited"), gd.NewCallable(cb), 0)
+func (self Instance) OnMouseExited(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "mouse_exited", cb, options...)
 }
 
 func (self class) AsCollisionObject3D() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -30,6 +30,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -519,16 +520,16 @@ func (self class) GetPickerShape() PickerShapeType { //gd:ColorPicker.get_picker
 	frame.Free()
 	return ret
 }
-func (self Instance) OnColorChanged(cb func(color Color.RGBA)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("color_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnColorChanged(cb func(color Color.RGBA), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "color_changed", cb, options...)
 }
 
-func (self Instance) OnPresetAdded(cb func(color Color.RGBA)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("preset_added"), gd.NewCallable(cb), 0)
+func (self Instance) OnPresetAdded(cb func(color Color.RGBA), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "preset_added", cb, options...)
 }
 
-func (self Instance) OnPresetRemoved(cb func(color Color.RGBA)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("preset_removed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPresetRemoved(cb func(color Color.RGBA), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "preset_removed", cb, options...)
 }
 
 func (self class) AsColorPicker() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
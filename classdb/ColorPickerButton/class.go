@@ -31,6 +31,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -202,16 +203,16 @@ func (self class) IsEditingAlpha() bool { //gd:ColorPickerButton.is_editing_alph
 	frame.Free()
 	return ret
 }
-func (self Instance) OnColorChanged(cb func(color Color.RGBA)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("color_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnColorChanged(cb func(color Color.RGBA), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "color_changed", cb, options...)
 }
 
-func (self Instance) OnPopupClosed(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("popup_closed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPopupClosed(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "popup_closed", cb, options...)
 }
 
-func (self Instance) OnPickerCreated(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("picker_created"), gd.NewCallable(cb), 0)
+func (self Instance) OnPickerCreated(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "picker_created", cb, options...)
 }
 
 func (self class) AsColorPickerButton() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -27,6 +27,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/Rect2"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -222,12 +223,12 @@ func (self class) FitChildInRect(child [1]gdclass.Control, rect Rect2.PositionSi
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.Container.Bind_fit_child_in_rect, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnPreSortChildren(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("pre_sort_children"), gd.NewCallable(cb), 0)
+func (self Instance) OnPreSortChildren(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "pre_sort_children", cb, options...)
 }
 
-func (self Instance) OnSortChildren(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("sort_children"), gd.NewCallable(cb), 0)
+func (self Instance) OnSortChildren(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "sort_children", cb, options...)
 }
 
 func (self class) AsContainer() Advanced               { return *((*Advanced)(unsafe.Pointer(&self))) }
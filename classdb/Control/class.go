@@ -34,6 +34,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector3i"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -3705,40 +3706,40 @@ func (self class) IsLocalizingNumeralSystem() bool { //gd:Control.is_localizing_
 	frame.Free()
 	return ret
 }
-func (self Instance) OnResized(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("resized"), gd.NewCallable(cb), 0)
+func (self Instance) OnResized(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "resized", cb, options...)
 }
 
-func (self Instance) OnGuiInput(cb func(event InputEvent.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("gui_input"), gd.NewCallable(cb), 0)
+func (self Instance) OnGuiInput(cb func(event InputEvent.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "gui_input", cb, options...)
 }
 
-func (self Instance) OnMouseEntered(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("mouse_entered"), gd.NewCallable(cb), 0)
+func (self Instance) OnMouseEntered(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "mouse_entered", cb, options...)
 }
 
-func (self Instance) OnMouseExited(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("mouse_exited"), gd.NewCallable(cb), 0)
+func (self Instance) OnMouseExited(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "mouse_exited", cb, options...)
 }
 
-func (self Instance) OnFocusEntered(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("focus_entered"), gd.NewCallable(cb), 0)
+func (self Instance) OnFocusEntered(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "focus_entered", cb, options...)
 }
 
-func (self Instance) OnFocusExited(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("focus_exited"), gd.NewCallable(cb), 0)
+func (self Instance) OnFocusExited(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "focus_exited", cb, options...)
 }
 
-func (self Instance) OnSizeFlagsChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("size_flags_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnSizeFlagsChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "size_flags_changed", cb, options...)
 }
 
-func (self Instance) OnMinimumSizeChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("minimum_size_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnMinimumSizeChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "minimum_size_changed", cb, options...)
 }
 
-func (self Instance) OnThemeChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("theme_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnThemeChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "theme_changed", cb, options...)
 }
 
 func (self class) AsControl() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
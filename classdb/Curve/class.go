@@ -25,6 +25,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -676,12 +677,12 @@ func (self class) SetBakeResolution(resolution int64) { //gd:Curve.set_bake_reso
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.Curve.Bind_set_bake_resolution, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnRangeChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("range_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnRangeChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "range_changed", cb, options...)
 }
 
-func (self Instance) OnDomainChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("domain_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnDomainChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "domain_changed", cb, options...)
 }
 
 func (self class) AsCurve() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
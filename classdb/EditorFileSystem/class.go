@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -279,28 +280,28 @@ func (self class) ReimportFiles(files Packed.Strings) { //gd:EditorFileSystem.re
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.EditorFileSystem.Bind_reimport_files, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnFilesystemChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("filesystem_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnFilesystemChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "filesystem_changed", cb, options...)
 }
 
-func (self Instance) OnScriptClassesUpdated(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("script_classes_updated"), gd.NewCallable(cb), 0)
+func (self Instance) OnScriptClassesUpdated(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "script_classes_updated", cb, options...)
 }
 
-func (self Instance) OnSourcesChanged(cb func(exist bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("sources_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnSourcesChanged(cb func(exist bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "sources_changed", cb, options...)
 }
 
-func (self Instance) OnResourcesReimporting(cb func(resources []string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("resources_reimporting"), gd.NewCallable(cb), 0)
+func (self Instance) OnResourcesReimporting(cb func(resources []string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "resources_reimporting", cb, options...)
 }
 
-func (self Instance) OnResourcesReimported(cb func(resources []string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("resources_reimported"), gd.NewCallable(cb), 0)
+func (self Instance) OnResourcesReimported(cb func(resources []string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "resources_reimported", cb, options...)
 }
 
-func (self Instance) OnResourcesReload(cb func(resources []string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("resources_reload"), gd.NewCallable(cb), 0)
+func (self Instance) OnResourcesReload(cb func(resources []string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "resources_reload", cb, options...)
 }
 
 func (self class) AsEditorFileSystem() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
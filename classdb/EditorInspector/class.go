@@ -31,6 +31,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -204,40 +205,40 @@ func (self class) InstantiatePropertyEditor(obj [1]gd.Object, atype variant.Type
 	frame.Free()
 	return ret
 }
-func (self Instance) OnPropertySelected(cb func(property string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("property_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnPropertySelected(cb func(property string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "property_selected", cb, options...)
 }
 
-func (self Instance) OnPropertyKeyed(cb func(property string, value any, advance bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("property_keyed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPropertyKeyed(cb func(property string, value any, advance bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "property_keyed", cb, options...)
 }
 
-func (self Instance) OnPropertyDeleted(cb func(property string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("property_deleted"), gd.NewCallable(cb), 0)
+func (self Instance) OnPropertyDeleted(cb func(property string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "property_deleted", cb, options...)
 }
 
-func (self Instance) OnResourceSelected(cb func(resource Resource.Instance, path string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("resource_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnResourceSelected(cb func(resource Resource.Instance, path string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "resource_selected", cb, options...)
 }
 
-func (self Instance) OnObjectIdSelected(cb func(id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("object_id_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnObjectIdSelected(cb func(id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "object_id_selected", cb, options...)
 }
 
-func (self Instance) OnPropertyEdited(cb func(property string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("property_edited"), gd.NewCallable(cb), 0)
+func (self Instance) OnPropertyEdited(cb func(property string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "property_edited", cb, options...)
 }
 
-func (self Instance) OnPropertyToggled(cb func(property string, checked bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("property_toggled"), gd.NewCallable(cb), 0)
+func (self Instance) OnPropertyToggled(cb func(property string, checked bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "property_toggled", cb, options...)
 }
 
-func (self Instance) OnEditedObjectChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("edited_object_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnEditedObjectChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "edited_object_changed", cb, options...)
 }
 
-func (self Instance) OnRestartRequested(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("restart_requested"), gd.NewCallable(cb), 0)
+func (self Instance) OnRestartRequested(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "restart_requested", cb, options...)
 }
 
 func (self class) AsEditorInspector() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
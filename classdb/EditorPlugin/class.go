@@ -48,6 +48,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -2675,28 +2676,28 @@ func (self class) GetPluginVersion() String.Readable { //gd:EditorPlugin.get_plu
 	frame.Free()
 	return ret
 }
-func (self Instance) OnSceneChanged(cb func(scene_root Node.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("scene_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnSceneChanged(cb func(scene_root Node.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "scene_changed", cb, options...)
 }
 
-func (self Instance) OnSceneClosed(cb func(filepath string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("scene_closed"), gd.NewCallable(cb), 0)
+func (self Instance) OnSceneClosed(cb func(filepath string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "scene_closed", cb, options...)
 }
 
-func (self Instance) OnMainScreenChanged(cb func(screen_name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("main_screen_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnMainScreenChanged(cb func(screen_name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "main_screen_changed", cb, options...)
 }
 
-func (self Instance) OnResourceSaved(cb func(resource Resource.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("resource_saved"), gd.NewCallable(cb), 0)
+func (self Instance) OnResourceSaved(cb func(resource Resource.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "resource_saved", cb, options...)
 }
 
-func (self Instance) OnSceneSaved(cb func(filepath string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("scene_saved"), gd.NewCallable(cb), 0)
+func (self Instance) OnSceneSaved(cb func(filepath string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "scene_saved", cb, options...)
 }
 
-func (self Instance) OnProjectSettingsChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("project_settings_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnProjectSettingsChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "project_settings_changed", cb, options...)
 }
 
 func (self class) AsEditorPlugin() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -28,6 +28,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -707,52 +708,52 @@ func (self class) EmitChanged(property String.Name, value variant.Any, field Str
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.EditorProperty.Bind_emit_changed, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnPropertyChanged(cb func(property string, value any, field string, changing bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("property_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPropertyChanged(cb func(property string, value any, field string, changing bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "property_changed", cb, options...)
 }
 
-func (self Instance) OnMultiplePropertiesChanged(cb func(properties []string, value []any)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("multiple_properties_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnMultiplePropertiesChanged(cb func(properties []string, value []any), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "multiple_properties_changed", cb, options...)
 }
 
-func (self Instance) OnPropertyKeyed(cb func(property string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("property_keyed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPropertyKeyed(cb func(property string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "property_keyed", cb, options...)
 }
 
-func (self Instance) OnPropertyDeleted(cb func(property string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("property_deleted"), gd.NewCallable(cb), 0)
+func (self Instance) OnPropertyDeleted(cb func(property string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "property_deleted", cb, options...)
 }
 
-func (self Instance) OnPropertyKeyedWithValue(cb func(property string, value any)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("property_keyed_with_value"), gd.NewCallable(cb), 0)
+func (self Instance) OnPropertyKeyedWithValue(cb func(property string, value any), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "property_keyed_with_value", cb, options...)
 }
 
-func (self Instance) OnPropertyChecked(cb func(property string, checked bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("property_checked"), gd.NewCallable(cb), 0)
+func (self Instance) OnPropertyChecked(cb func(property string, checked bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "property_checked", cb, options...)
 }
 
-func (self Instance) OnPropertyFavorited(cb func(property string, favorited bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("property_favorited"), gd.NewCallable(cb), 0)
+func (self Instance) OnPropertyFavorited(cb func(property string, favorited bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "property_favorited", cb, options...)
 }
 
-func (self Instance) OnPropertyPinned(cb func(property string, pinned bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("property_pinned"), gd.NewCallable(cb), 0)
+func (self Instance) OnPropertyPinned(cb func(property string, pinned bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "property_pinned", cb, options...)
 }
 
-func (self Instance) OnPropertyCanRevertChanged(cb func(property string, can_revert bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("property_can_revert_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPropertyCanRevertChanged(cb func(property string, can_revert bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "property_can_revert_changed", cb, options...)
 }
 
-func (self Instance) OnResourceSelected(cb func(path string, resource Resource.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("resource_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnResourceSelected(cb func(path string, resource Resource.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "resource_selected", cb, options...)
 }
 
-func (self Instance) OnObjectIdSelected(cb func(property string, id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("object_id_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnObjectIdSelected(cb func(property string, id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "object_id_selected", cb, options...)
 }
 
-func (self Instance) OnSelected(cb func(path string, focusable_idx int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnSelected(cb func(path string, focusable_idx int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "selected", cb, options...)
 }
 
 func (self class) AsEditorProperty() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
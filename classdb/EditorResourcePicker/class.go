@@ -30,6 +30,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -316,12 +317,12 @@ func (self class) IsEditable() bool { //gd:EditorResourcePicker.is_editable
 	frame.Free()
 	return ret
 }
-func (self Instance) OnResourceSelected(cb func(resource Resource.Instance, inspect bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("resource_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnResourceSelected(cb func(resource Resource.Instance, inspect bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "resource_selected", cb, options...)
 }
 
-func (self Instance) OnResourceChanged(cb func(resource Resource.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("resource_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnResourceChanged(cb func(resource Resource.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "resource_changed", cb, options...)
 }
 
 func (self class) AsEditorResourcePicker() Advanced    { return *((*Advanced)(unsafe.Pointer(&self))) }
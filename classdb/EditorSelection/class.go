@@ -24,6 +24,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -194,8 +195,8 @@ func (self class) GetTransformableSelectedNodes() Array.Contains[[1]gdclass.Node
 	frame.Free()
 	return ret
 }
-func (self Instance) OnSelectionChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("selection_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnSelectionChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "selection_changed", cb, options...)
 }
 
 func (self class) AsEditorSelection() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
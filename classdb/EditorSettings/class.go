@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -515,8 +516,8 @@ func (self class) MarkSettingChanged(setting String.Readable) { //gd:EditorSetti
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.EditorSettings.Bind_mark_setting_changed, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnSettingsChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("settings_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnSettingsChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "settings_changed", cb, options...)
 }
 
 func (self class) AsEditorSettings() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
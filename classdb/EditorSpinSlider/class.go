@@ -27,6 +27,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -261,24 +262,24 @@ func (self class) IsEditingInteger() bool { //gd:EditorSpinSlider.is_editing_int
 	frame.Free()
 	return ret
 }
-func (self Instance) OnGrabbed(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("grabbed"), gd.NewCallable(cb), 0)
+func (self Instance) OnGrabbed(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "grabbed", cb, options...)
 }
 
-func (self Instance) OnUngrabbed(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("ungrabbed"), gd.NewCallable(cb), 0)
+func (self Instance) OnUngrabbed(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "ungrabbed", cb, options...)
 }
 
-func (self Instance) OnUpdownPressed(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("updown_pressed"), gd.NewCallable(cb), 0)
+func (self Instance) OnUpdownPressed(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "updown_pressed", cb, options...)
 }
 
-func (self Instance) OnValueFocusEntered(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("value_focus_entered"), gd.NewCallable(cb), 0)
+func (self Instance) OnValueFocusEntered(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "value_focus_entered", cb, options...)
 }
 
-func (self Instance) OnValueFocusExited(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("value_focus_exited"), gd.NewCallable(cb), 0)
+func (self Instance) OnValueFocusExited(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "value_focus_exited", cb, options...)
 }
 
 func (self class) AsEditorSpinSlider() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
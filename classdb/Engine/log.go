@@ -0,0 +1,106 @@
+package Engine
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync"
+
+	gd "graphics.gd/internal"
+)
+
+// LogHandler returns a [slog.Handler] that writes records to the engine's output, such that
+// slog-based libraries can log from inside the game. Records below [slog.LevelInfo] are only
+// printed in verbose mode (see [Logv]) and info records are printed with a coloured level (see
+// [PrintRich]), whilst warnings and errors are pushed to the editor's debugger (see [RaiseWarning]
+// and [Raise]) along with the source location that they were logged from. Attributes follow the
+// message as key=value pairs, as formatted by [slog.TextHandler] with the given options.
+//
+//	slog.SetDefault(slog.New(Engine.LogHandler(nil)))
+func LogHandler(options *slog.HandlerOptions) slog.Handler {
+	var opts slog.HandlerOptions
+	if options != nil {
+		opts = *options
+	}
+	handler := &logHandler{output: new(logOutput), level: opts.Level}
+	if handler.level == nil {
+		handler.level = slog.LevelInfo
+	}
+	replace := opts.ReplaceAttr
+	opts.ReplaceAttr = func(groups []string, attr slog.Attr) slog.Attr {
+		if len(groups) == 0 {
+			switch attr.Key {
+			case slog.TimeKey, slog.LevelKey, slog.MessageKey:
+				return slog.Attr{}
+			}
+		}
+		if replace != nil {
+			return replace(groups, attr)
+		}
+		return attr
+	}
+	handler.text = slog.NewTextHandler(&handler.output.buf, &opts)
+	return handler
+}
+
+type logHandler struct {
+	output *logOutput
+	text   slog.Handler // formats the attributes of each record.
+	level  slog.Leveler
+}
+
+// logOutput is shared by each [logHandler] derived from the same [LogHandler].
+type logOutput struct {
+	mutex sync.Mutex
+	buf   bytes.Buffer
+}
+
+func (h *logHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+func (h *logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	derived := *h
+	derived.text = h.text.WithAttrs(attrs)
+	return &derived
+}
+
+func (h *logHandler) WithGroup(name string) slog.Handler {
+	derived := *h
+	derived.text = h.text.WithGroup(name)
+	return &derived
+}
+
+func (h *logHandler) Handle(ctx context.Context, record slog.Record) error {
+	h.output.mutex.Lock()
+	h.output.buf.Reset()
+	err := h.text.Handle(ctx, record)
+	attrs := strings.TrimSpace(h.output.buf.String())
+	h.output.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	message := record.Message
+	if attrs != "" {
+		message += " " + attrs
+	}
+	var function, file string
+	var line int
+	if record.PC != 0 {
+		frame, _ := runtime.CallersFrames([]uintptr{record.PC}).Next()
+		function, file, line = frame.Function, frame.File, frame.Line
+	}
+	switch {
+	case record.Level < slog.LevelInfo:
+		gd.PrintVerbose(gd.NewVariant(message))
+	case record.Level < slog.LevelWarn:
+		gd.PrintRich(gd.NewVariant("[color=cyan]" + record.Level.String() + "[/color] " + strings.ReplaceAll(message, "[", "[lb]")))
+	case record.Level < slog.LevelError:
+		gd.Global.PrintWarning(message, function, file, int32(line), true)
+	default:
+		gd.Global.PrintErrorMessage(record.Message, message, function, file, int32(line), true)
+	}
+	return nil
+}
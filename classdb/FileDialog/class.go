@@ -30,6 +30,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -746,20 +747,20 @@ func (self class) Invalidate() { //gd:FileDialog.invalidate
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.FileDialog.Bind_invalidate, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnFileSelected(cb func(path string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("file_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnFileSelected(cb func(path string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "file_selected", cb, options...)
 }
 
-func (self Instance) OnFilesSelected(cb func(paths []string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("files_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnFilesSelected(cb func(paths []string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "files_selected", cb, options...)
 }
 
-func (self Instance) OnDirSelected(cb func(dir string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("dir_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnDirSelected(cb func(dir string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "dir_selected", cb, options...)
 }
 
-func (self Instance) OnFilenameFilterChanged(cb func(filter string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("filename_filter_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnFilenameFilterChanged(cb func(filter string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "filename_filter_changed", cb, options...)
 }
 
 func (self class) AsFileDialog() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
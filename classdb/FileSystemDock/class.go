@@ -31,6 +31,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -160,40 +161,40 @@ func (self class) RemoveResourceTooltipPlugin(plugin [1]gdclass.EditorResourceTo
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.FileSystemDock.Bind_remove_resource_tooltip_plugin, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnInherit(cb func(file string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("inherit"), gd.NewCallable(cb), 0)
+func (self Instance) OnInherit(cb func(file string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "inherit", cb, options...)
 }
 
-func (self Instance) OnInstantiate(cb func(files []string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("instantiate"), gd.NewCallable(cb), 0)
+func (self Instance) OnInstantiate(cb func(files []string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "instantiate", cb, options...)
 }
 
-func (self Instance) OnResourceRemoved(cb func(resource Resource.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("resource_removed"), gd.NewCallable(cb), 0)
+func (self Instance) OnResourceRemoved(cb func(resource Resource.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "resource_removed", cb, options...)
 }
 
-func (self Instance) OnFileRemoved(cb func(file string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("file_removed"), gd.NewCallable(cb), 0)
+func (self Instance) OnFileRemoved(cb func(file string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "file_removed", cb, options...)
 }
 
-func (self Instance) OnFolderRemoved(cb func(folder string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("folder_removed"), gd.NewCallable(cb), 0)
+func (self Instance) OnFolderRemoved(cb func(folder string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "folder_removed", cb, options...)
 }
 
-func (self Instance) OnFilesMoved(cb func(old_file string, new_file string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("files_moved"), gd.NewCallable(cb), 0)
+func (self Instance) OnFilesMoved(cb func(old_file string, new_file string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "files_moved", cb, options...)
 }
 
-func (self Instance) OnFolderMoved(cb func(old_folder string, new_folder string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("folder_moved"), gd.NewCallable(cb), 0)
+func (self Instance) OnFolderMoved(cb func(old_folder string, new_folder string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "folder_moved", cb, options...)
 }
 
-func (self Instance) OnFolderColorChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("folder_color_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnFolderColorChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "folder_color_changed", cb, options...)
 }
 
-func (self Instance) OnDisplayModeChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("display_mode_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnDisplayModeChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "display_mode_changed", cb, options...)
 }
 
 func (self class) AsFileSystemDock() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -226,16 +227,19 @@ func (self class) GetExtension(path String.Readable) [1]gdclass.GDExtension { //
 	frame.Free()
 	return ret
 }
-func OnExtensionsReloaded(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("extensions_reloaded"), gd.NewCallable(cb), 0)
+func OnExtensionsReloaded(cb func(), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "extensions_reloaded", cb, options...)
 }
 
-func OnExtensionLoaded(cb func(extension GDExtension.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("extension_loaded"), gd.NewCallable(cb), 0)
+func OnExtensionLoaded(cb func(extension GDExtension.Instance), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "extension_loaded", cb, options...)
 }
 
-func OnExtensionUnloading(cb func(extension GDExtension.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("extension_unloading"), gd.NewCallable(cb), 0)
+func OnExtensionUnloading(cb func(extension GDExtension.Instance), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "extension_unloading", cb, options...)
 }
 
 func (self class) Virtual(name string) reflect.Value {
@@ -30,6 +30,7 @@ import "graphics.gd/variant/Rect2"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1625,80 +1626,80 @@ func (self class) SetSelected(node [1]gdclass.Node) { //gd:GraphEdit.set_selecte
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.GraphEdit.Bind_set_selected, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnConnectionRequest(cb func(from_node string, from_port int, to_node string, to_port int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("connection_request"), gd.NewCallable(cb), 0)
+func (self Instance) OnConnectionRequest(cb func(from_node string, from_port int, to_node string, to_port int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "connection_request", cb, options...)
 }
 
-func (self Instance) OnDisconnectionRequest(cb func(from_node string, from_port int, to_node string, to_port int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("disconnection_request"), gd.NewCallable(cb), 0)
+func (self Instance) OnDisconnectionRequest(cb func(from_node string, from_port int, to_node string, to_port int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "disconnection_request", cb, options...)
 }
 
-func (self Instance) OnConnectionToEmpty(cb func(from_node string, from_port int, release_position Vector2.XY)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("connection_to_empty"), gd.NewCallable(cb), 0)
+func (self Instance) OnConnectionToEmpty(cb func(from_node string, from_port int, release_position Vector2.XY), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "connection_to_empty", cb, options...)
 }
 
-func (self Instance) OnConnectionFromEmpty(cb func(to_node string, to_port int, release_position Vector2.XY)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("connection_from_empty"), gd.NewCallable(cb), 0)
+func (self Instance) OnConnectionFromEmpty(cb func(to_node string, to_port int, release_position Vector2.XY), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "connection_from_empty", cb, options...)
 }
 
-func (self Instance) OnConnectionDragStarted(cb func(from_node string, from_port int, is_output bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("connection_drag_started"), gd.NewCallable(cb), 0)
+func (self Instance) OnConnectionDragStarted(cb func(from_node string, from_port int, is_output bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "connection_drag_started", cb, options...)
 }
 
-func (self Instance) OnConnectionDragEnded(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("connection_drag_ended"), gd.NewCallable(cb), 0)
+func (self Instance) OnConnectionDragEnded(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "connection_drag_ended", cb, options...)
 }
 
-func (self Instance) OnCopyNodesRequest(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("copy_nodes_request"), gd.NewCallable(cb), 0)
+func (self Instance) OnCopyNodesRequest(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "copy_nodes_request", cb, options...)
 }
 
-func (self Instance) OnCutNodesRequest(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("cut_nodes_request"), gd.NewCallable(cb), 0)
+func (self Instance) OnCutNodesRequest(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "cut_nodes_request", cb, options...)
 }
 
-func (self Instance) OnPasteNodesRequest(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("paste_nodes_request"), gd.NewCallable(cb), 0)
+func (self Instance) OnPasteNodesRequest(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "paste_nodes_request", cb, options...)
 }
 
-func (self Instance) OnDuplicateNodesRequest(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("duplicate_nodes_request"), gd.NewCallable(cb), 0)
+func (self Instance) OnDuplicateNodesRequest(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "duplicate_nodes_request", cb, options...)
 }
 
-func (self Instance) OnDeleteNodesRequest(cb func(nodes []string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("delete_nodes_request"), gd.NewCallable(cb), 0)
+func (self Instance) OnDeleteNodesRequest(cb func(nodes []string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "delete_nodes_request", cb, options...)
 }
 
-func (self Instance) OnNodeSelected(cb func(node Node.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("node_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnNodeSelected(cb func(node Node.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "node_selected", cb, options...)
 }
 
-func (self Instance) OnNodeDeselected(cb func(node Node.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("node_deselected"), gd.NewCallable(cb), 0)
+func (self Instance) OnNodeDeselected(cb func(node Node.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "node_deselected", cb, options...)
 }
 
-func (self Instance) OnFrameRectChanged(cb func(frame_ GraphFrame.Instance, new_rect Rect2.PositionSize)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("frame_rect_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnFrameRectChanged(cb func(frame_ GraphFrame.Instance, new_rect Rect2.PositionSize), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "frame_rect_changed", cb, options...)
 }
 
-func (self Instance) OnPopupRequest(cb func(at_position Vector2.XY)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("popup_request"), gd.NewCallable(cb), 0)
+func (self Instance) OnPopupRequest(cb func(at_position Vector2.XY), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "popup_request", cb, options...)
 }
 
-func (self Instance) OnBeginNodeMove(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("begin_node_move"), gd.NewCallable(cb), 0)
+func (self Instance) OnBeginNodeMove(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "begin_node_move", cb, options...)
 }
 
-func (self Instance) OnEndNodeMove(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("end_node_move"), gd.NewCallable(cb), 0)
+func (self Instance) OnEndNodeMove(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "end_node_move", cb, options...)
 }
 
-func (self Instance) OnGraphElementsLinkedToFrameRequest(cb func(elements []any, frame_ string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("graph_elements_linked_to_frame_request"), gd.NewCallable(cb), 0)
+func (self Instance) OnGraphElementsLinkedToFrameRequest(cb func(elements []any, frame_ string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "graph_elements_linked_to_frame_request", cb, options...)
 }
 
-func (self Instance) OnScrollOffsetChanged(cb func(offset Vector2.XY)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("scroll_offset_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnScrollOffsetChanged(cb func(offset Vector2.XY), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "scroll_offset_changed", cb, options...)
 }
 
 func (self class) AsGraphEdit() Advanced               { return *((*Advanced)(unsafe.Pointer(&self))) }
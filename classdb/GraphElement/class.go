@@ -28,6 +28,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -234,36 +235,36 @@ func (self class) GetPositionOffset() Vector2.XY { //gd:GraphElement.get_positio
 	frame.Free()
 	return ret
 }
-func (self Instance) OnNodeSelected(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("node_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnNodeSelected(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "node_selected", cb, options...)
 }
 
-func (self Instance) OnNodeDeselected(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("node_deselected"), gd.NewCallable(cb), 0)
+func (self Instance) OnNodeDeselected(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "node_deselected", cb, options...)
 }
 
-func (self Instance) OnRaiseRequest(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("raise_request"), gd.NewCallable(cb), 0)
+func (self Instance) OnRaiseRequest(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "raise_request", cb, options...)
 }
 
-func (self Instance) OnDeleteRequest(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("delete_request"), gd.NewCallable(cb), 0)
+func (self Instance) OnDeleteRequest(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "delete_request", cb, options...)
 }
 
-func (self Instance) OnResizeRequest(cb func(new_size Vector2.XY)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("resize_request"), gd.NewCallable(cb), 0)
+func (self Instance) OnResizeRequest(cb func(new_size Vector2.XY), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "resize_request", cb, options...)
 }
 
-func (self Instance) OnResizeEnd(cb func(new_size Vector2.XY)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("resize_end"), gd.NewCallable(cb), 0)
+func (self Instance) OnResizeEnd(cb func(new_size Vector2.XY), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "resize_end", cb, options...)
 }
 
-func (self Instance) OnDragged(cb func(from Vector2.XY, to Vector2.XY)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("dragged"), gd.NewCallable(cb), 0)
+func (self Instance) OnDragged(cb func(from Vector2.XY, to Vector2.XY), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "dragged", cb, options...)
 }
 
-func (self Instance) OnPositionOffsetChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("position_offset_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPositionOffsetChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "position_offset_changed", cb, options...)
 }
 
 func (self class) AsGraphElement() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -30,6 +30,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -286,8 +287,8 @@ func (self class) GetTintColor() Color.RGBA { //gd:GraphFrame.get_tint_color
 	frame.Free()
 	return ret
 }
-func (self Instance) OnAutoshrinkChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("autoshrink_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnAutoshrinkChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "autoshrink_changed", cb, options...)
 }
 
 func (self class) AsGraphFrame() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -33,6 +33,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector2i"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -885,8 +886,8 @@ func (self class) GetOutputPortSlot(port_idx int64) int64 { //gd:GraphNode.get_o
 	frame.Free()
 	return ret
 }
-func (self Instance) OnSlotUpdated(cb func(slot_index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("slot_updated"), gd.NewCallable(cb), 0)
+func (self Instance) OnSlotUpdated(cb func(slot_index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "slot_updated", cb, options...)
 }
 
 func (self class) AsGraphNode() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
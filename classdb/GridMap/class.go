@@ -31,6 +31,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector3"
 import "graphics.gd/variant/Vector3i"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -920,12 +921,12 @@ func (self class) MakeBakedMeshes(gen_lightmap_uv bool, lightmap_uv_texel_size f
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.GridMap.Bind_make_baked_meshes, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnCellSizeChanged(cb func(cell_size Vector3.XYZ)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("cell_size_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnCellSizeChanged(cb func(cell_size Vector3.XYZ), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "cell_size_changed", cb, options...)
 }
 
-func (self Instance) OnChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "changed", cb, options...)
 }
 
 func (self class) AsGridMap() Advanced               { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -26,6 +26,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -663,8 +664,8 @@ func (self class) SetHttpsProxy(host String.Readable, port int64) { //gd:HTTPReq
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.HTTPRequest.Bind_set_https_proxy, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnRequestCompleted(cb func(result int, response_code int, headers []string, body []byte)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("request_completed"), gd.NewCallable(cb), 0)
+func (self Instance) OnRequestCompleted(cb func(result int, response_code int, headers []string, body []byte), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "request_completed", cb, options...)
 }
 
 func (self class) AsHTTPRequest() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
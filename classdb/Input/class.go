@@ -28,6 +28,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector3"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1489,8 +1490,9 @@ func (self class) IsEmulatingTouchFromMouse() bool { //gd:Input.is_emulating_tou
 	frame.Free()
 	return ret
 }
-func OnJoyConnectionChanged(cb func(device int, connected bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("joy_connection_changed"), gd.NewCallable(cb), 0)
+func OnJoyConnectionChanged(cb func(device int, connected bool), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "joy_connection_changed", cb, options...)
 }
 
 func (self class) Virtual(name string) reflect.Value {
@@ -34,6 +34,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector2i"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1577,24 +1578,24 @@ func (self class) ForceUpdateListSize() { //gd:ItemList.force_update_list_size
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.ItemList.Bind_force_update_list_size, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnItemSelected(cb func(index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("item_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnItemSelected(cb func(index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "item_selected", cb, options...)
 }
 
-func (self Instance) OnEmptyClicked(cb func(at_position Vector2.XY, mouse_button_index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("empty_clicked"), gd.NewCallable(cb), 0)
+func (self Instance) OnEmptyClicked(cb func(at_position Vector2.XY, mouse_button_index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "empty_clicked", cb, options...)
 }
 
-func (self Instance) OnItemClicked(cb func(index int, at_position Vector2.XY, mouse_button_index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("item_clicked"), gd.NewCallable(cb), 0)
+func (self Instance) OnItemClicked(cb func(index int, at_position Vector2.XY, mouse_button_index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "item_clicked", cb, options...)
 }
 
-func (self Instance) OnMultiSelected(cb func(index int, selected bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("multi_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnMultiSelected(cb func(index int, selected bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "multi_selected", cb, options...)
 }
 
-func (self Instance) OnItemActivated(cb func(index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("item_activated"), gd.NewCallable(cb), 0)
+func (self Instance) OnItemActivated(cb func(index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "item_activated", cb, options...)
 }
 
 func (self class) AsItemList() Advanced                { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -352,8 +353,9 @@ func (self class) ForceFsSync() { //gd:JavaScriptBridge.force_fs_sync
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.JavaScriptBridge.Bind_force_fs_sync, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func OnPwaUpdateAvailable(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("pwa_update_available"), gd.NewCallable(cb), 0)
+func OnPwaUpdateAvailable(cb func(), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "pwa_update_available", cb, options...)
 }
 
 func (self class) Virtual(name string) reflect.Value {
@@ -30,6 +30,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1575,20 +1576,20 @@ func (self class) IsSelectAllOnFocus() bool { //gd:LineEdit.is_select_all_on_foc
 	frame.Free()
 	return ret
 }
-func (self Instance) OnTextChanged(cb func(new_text string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("text_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTextChanged(cb func(new_text string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "text_changed", cb, options...)
 }
 
-func (self Instance) OnTextChangeRejected(cb func(rejected_substring string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("text_change_rejected"), gd.NewCallable(cb), 0)
+func (self Instance) OnTextChangeRejected(cb func(rejected_substring string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "text_change_rejected", cb, options...)
 }
 
-func (self Instance) OnTextSubmitted(cb func(new_text string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("text_submitted"), gd.NewCallable(cb), 0)
+func (self Instance) OnTextSubmitted(cb func(new_text string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "text_submitted", cb, options...)
 }
 
-func (self Instance) OnEditingToggled(cb func(toggled_on bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("editing_toggled"), gd.NewCallable(cb), 0)
+func (self Instance) OnEditingToggled(cb func(toggled_on bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "editing_toggled", cb, options...)
 }
 
 func (self class) AsLineEdit() Advanced                { return *((*Advanced)(unsafe.Pointer(&self))) }
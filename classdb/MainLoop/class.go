@@ -23,6 +23,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -274,8 +275,8 @@ func (class) _finalize(impl func(ptr unsafe.Pointer)) (cb gd.ExtensionClassCallV
 	}
 }
 
-func (self Instance) OnOnRequestPermissionsResult(cb func(permission string, granted bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("on_request_permissions_result"), gd.NewCallable(cb), 0)
+func (self Instance) OnOnRequestPermissionsResult(cb func(permission string, granted bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "on_request_permissions_result", cb, options...)
 }
 
 func (self class) AsMainLoop() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
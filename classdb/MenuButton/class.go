@@ -29,6 +29,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -214,8 +215,8 @@ func (self class) GetItemCount() int64 { //gd:MenuButton.get_item_count
 	frame.Free()
 	return ret
 }
-func (self Instance) OnAboutToPopup(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("about_to_popup"), gd.NewCallable(cb), 0)
+func (self Instance) OnAboutToPopup(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "about_to_popup", cb, options...)
 }
 
 func (self class) AsMenuButton() Advanced            { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -28,6 +28,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -154,8 +155,8 @@ func (self class) GetTexture() [1]gdclass.Texture2D { //gd:MultiMeshInstance2D.g
 	frame.Free()
 	return ret
 }
-func (self Instance) OnTextureChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("texture_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTextureChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "texture_changed", cb, options...)
 }
 
 func (self class) AsMultiMeshInstance2D() Advanced    { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -24,6 +24,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -396,24 +397,24 @@ func (self class) CreateDefaultInterface() [1]gdclass.MultiplayerAPI { //gd:Mult
 	frame.Free()
 	return ret
 }
-func (self Instance) OnPeerConnected(cb func(id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("peer_connected"), gd.NewCallable(cb), 0)
+func (self Instance) OnPeerConnected(cb func(id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "peer_connected", cb, options...)
 }
 
-func (self Instance) OnPeerDisconnected(cb func(id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("peer_disconnected"), gd.NewCallable(cb), 0)
+func (self Instance) OnPeerDisconnected(cb func(id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "peer_disconnected", cb, options...)
 }
 
-func (self Instance) OnConnectedToServer(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("connected_to_server"), gd.NewCallable(cb), 0)
+func (self Instance) OnConnectedToServer(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "connected_to_server", cb, options...)
 }
 
-func (self Instance) OnConnectionFailed(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("connection_failed"), gd.NewCallable(cb), 0)
+func (self Instance) OnConnectionFailed(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "connection_failed", cb, options...)
 }
 
-func (self Instance) OnServerDisconnected(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("server_disconnected"), gd.NewCallable(cb), 0)
+func (self Instance) OnServerDisconnected(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "server_disconnected", cb, options...)
 }
 
 func (self class) AsMultiplayerAPI() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
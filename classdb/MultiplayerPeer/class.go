@@ -24,6 +24,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -405,12 +406,12 @@ func (self class) IsServerRelaySupported() bool { //gd:MultiplayerPeer.is_server
 	frame.Free()
 	return ret
 }
-func (self Instance) OnPeerConnected(cb func(id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("peer_connected"), gd.NewCallable(cb), 0)
+func (self Instance) OnPeerConnected(cb func(id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "peer_connected", cb, options...)
 }
 
-func (self Instance) OnPeerDisconnected(cb func(id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("peer_disconnected"), gd.NewCallable(cb), 0)
+func (self Instance) OnPeerDisconnected(cb func(id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "peer_disconnected", cb, options...)
 }
 
 func (self class) AsMultiplayerPeer() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -24,6 +24,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -289,12 +290,12 @@ func (self class) SetSpawnFunction(spawn_function Callable.Function) { //gd:Mult
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.MultiplayerSpawner.Bind_set_spawn_function, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnDespawned(cb func(node Node.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("despawned"), gd.NewCallable(cb), 0)
+func (self Instance) OnDespawned(cb func(node Node.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "despawned", cb, options...)
 }
 
-func (self Instance) OnSpawned(cb func(node Node.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("spawned"), gd.NewCallable(cb), 0)
+func (self Instance) OnSpawned(cb func(node Node.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "spawned", cb, options...)
 }
 
 func (self class) AsMultiplayerSpawner() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
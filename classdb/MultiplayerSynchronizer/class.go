@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -371,16 +372,16 @@ func (self class) GetVisibilityFor(peer int64) bool { //gd:MultiplayerSynchroniz
 	frame.Free()
 	return ret
 }
-func (self Instance) OnSynchronized(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("synchronized"), gd.NewCallable(cb), 0)
+func (self Instance) OnSynchronized(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "synchronized", cb, options...)
 }
 
-func (self Instance) OnDeltaSynchronized(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("delta_synchronized"), gd.NewCallable(cb), 0)
+func (self Instance) OnDeltaSynchronized(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "delta_synchronized", cb, options...)
 }
 
-func (self Instance) OnVisibilityChanged(cb func(for_peer int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("visibility_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnVisibilityChanged(cb func(for_peer int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "visibility_changed", cb, options...)
 }
 
 func (self class) AsMultiplayerSynchronizer() Advanced { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -28,6 +28,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1186,28 +1187,28 @@ func (self class) GetDebugPathCustomLineWidth() float64 { //gd:NavigationAgent2D
 	frame.Free()
 	return ret
 }
-func (self Instance) OnPathChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("path_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPathChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "path_changed", cb, options...)
 }
 
-func (self Instance) OnTargetReached(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("target_reached"), gd.NewCallable(cb), 0)
+func (self Instance) OnTargetReached(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "target_reached", cb, options...)
 }
 
-func (self Instance) OnWaypointReached(cb func(details map[any]any)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("waypoint_reached"), gd.NewCallable(cb), 0)
+func (self Instance) OnWaypointReached(cb func(details map[any]any), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "waypoint_reached", cb, options...)
 }
 
-func (self Instance) OnLinkReached(cb func(details map[any]any)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("link_reached"), gd.NewCallable(cb), 0)
+func (self Instance) OnLinkReached(cb func(details map[any]any), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "link_reached", cb, options...)
 }
 
-func (self Instance) OnNavigationFinished(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("navigation_finished"), gd.NewCallable(cb), 0)
+func (self Instance) OnNavigationFinished(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "navigation_finished", cb, options...)
 }
 
-func (self Instance) OnVelocityComputed(cb func(safe_velocity Vector2.XY)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("velocity_computed"), gd.NewCallable(cb), 0)
+func (self Instance) OnVelocityComputed(cb func(safe_velocity Vector2.XY), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "velocity_computed", cb, options...)
 }
 
 func (self class) AsNavigationAgent2D() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -28,6 +28,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/Rect2"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -455,12 +456,12 @@ func (self class) GetBounds() Rect2.PositionSize { //gd:NavigationRegion2D.get_b
 	frame.Free()
 	return ret
 }
-func (self Instance) OnNavigationPolygonChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("navigation_polygon_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnNavigationPolygonChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "navigation_polygon_changed", cb, options...)
 }
 
-func (self Instance) OnBakeFinished(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("bake_finished"), gd.NewCallable(cb), 0)
+func (self Instance) OnBakeFinished(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "bake_finished", cb, options...)
 }
 
 func (self class) AsNavigationRegion2D() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
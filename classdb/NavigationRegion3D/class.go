@@ -27,6 +27,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -454,12 +455,12 @@ func (self class) GetBounds() AABB.PositionSize { //gd:NavigationRegion3D.get_bo
 	frame.Free()
 	return ret
 }
-func (self Instance) OnNavigationMeshChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("navigation_mesh_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnNavigationMeshChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "navigation_mesh_changed", cb, options...)
 }
 
-func (self Instance) OnBakeFinished(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("bake_finished"), gd.NewCallable(cb), 0)
+func (self Instance) OnBakeFinished(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "bake_finished", cb, options...)
 }
 
 func (self class) AsNavigationRegion3D() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
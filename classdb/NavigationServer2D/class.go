@@ -32,6 +32,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Transform2D"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -2975,12 +2976,14 @@ func (self class) GetDebugEnabled() bool { //gd:NavigationServer2D.get_debug_ena
 	frame.Free()
 	return ret
 }
-func OnMapChanged(cb func(mapping RID.Any)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("map_changed"), gd.NewCallable(cb), 0)
+func OnMapChanged(cb func(mapping RID.Any), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "map_changed", cb, options...)
 }
 
-func OnNavigationDebugChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("navigation_debug_changed"), gd.NewCallable(cb), 0)
+func OnNavigationDebugChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "navigation_debug_changed", cb, options...)
 }
 
 func (self class) Virtual(name string) reflect.Value {
@@ -28,6 +28,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/Rect2"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -293,8 +294,8 @@ func (self class) GetVAxisStretchMode() AxisStretchMode { //gd:NinePatchRect.get
 	frame.Free()
 	return ret
 }
-func (self Instance) OnTextureChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("texture_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTextureChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "texture_changed", cb, options...)
 }
 
 func (self class) AsNinePatchRect() Advanced           { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -27,6 +27,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -3401,48 +3402,48 @@ func (self class) NotifyThreadSafe(what int64) { //gd:Node.notify_thread_safe
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.Node.Bind_notify_thread_safe, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnReady(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("ready"), gd.NewCallable(cb), 0)
+func (self Instance) OnReady(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "ready", cb, options...)
 }
 
-func (self Instance) OnRenamed(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("renamed"), gd.NewCallable(cb), 0)
+func (self Instance) OnRenamed(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "renamed", cb, options...)
 }
 
-func (self Instance) OnTreeEntered(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tree_entered"), gd.NewCallable(cb), 0)
+func (self Instance) OnTreeEntered(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tree_entered", cb, options...)
 }
 
-func (self Instance) OnTreeExiting(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tree_exiting"), gd.NewCallable(cb), 0)
+func (self Instance) OnTreeExiting(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tree_exiting", cb, options...)
 }
 
-func (self Instance) OnTreeExited(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tree_exited"), gd.NewCallable(cb), 0)
+func (self Instance) OnTreeExited(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tree_exited", cb, options...)
 }
 
-func (self Instance) OnChildEnteredTree(cb func(node Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("child_entered_tree"), gd.NewCallable(cb), 0)
+func (self Instance) OnChildEnteredTree(cb func(node Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "child_entered_tree", cb, options...)
 }
 
-func (self Instance) OnChildExitingTree(cb func(node Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("child_exiting_tree"), gd.NewCallable(cb), 0)
+func (self Instance) OnChildExitingTree(cb func(node Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "child_exiting_tree", cb, options...)
 }
 
-func (self Instance) OnChildOrderChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("child_order_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnChildOrderChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "child_order_changed", cb, options...)
 }
 
-func (self Instance) OnReplacingBy(cb func(node Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("replacing_by"), gd.NewCallable(cb), 0)
+func (self Instance) OnReplacingBy(cb func(node Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "replacing_by", cb, options...)
 }
 
-func (self Instance) OnEditorDescriptionChanged(cb func(node Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("editor_description_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnEditorDescriptionChanged(cb func(node Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "editor_description_changed", cb, options...)
 }
 
-func (self Instance) OnEditorStateChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("editor_state_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnEditorStateChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "editor_state_changed", cb, options...)
 }
 
 func (self class) AsNode() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -1,6 +1,43 @@
 package Node
 
+import (
+	"context"
+	"errors"
+
+	gd "graphics.gd/internal"
+)
+
 // IsQueuedForDeletion returns true if the [Instance.QueueFree] method was called for the object.
 func (self Instance) IsQueuedForDeletion() bool {
 	return bool(self[0].AsObject()[0].IsQueuedForDeletion())
 }
+
+var errTreeExited = errors.New("node exited the scene tree")
+
+// Context returns a context that is cancelled the next time that the node exits the scene tree,
+// when the node is freed or when the engine shuts down, such that goroutines working on behalf of
+// the node can stop. Built-in nodes that are freed outside of the scene tree are only detected
+// by the engine shutdown, whereas classes registered with classdb are always detected.
+//
+// Context must be called on the main thread (like any other engine method), as it connects to
+// the node's tree_exiting signal, the context itself can then be used from any goroutine. Here,
+// the argument is evaluated on the main thread, before the goroutine starts:
+//
+//	go func(ctx context.Context) {
+//		for {
+//			select {
+//			case <-ctx.Done():
+//				return
+//			case msg := <-network:
+//				startup.Do(func() { handle(msg) })
+//			}
+//		}
+//	}(Node.Context(node))
+func Context(node Instance) context.Context {
+	id := gd.ObjectID(node.ID())
+	return gd.ObjectContext(id, func() {
+		node[0].AsObject()[0].Connect(gd.NewStringName("tree_exiting"), gd.NewCallable(func() {
+			gd.CancelObjectContext(id, errTreeExited)
+		}), 4) // CONNECT_ONE_SHOT
+	})
+}
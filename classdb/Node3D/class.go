@@ -30,6 +30,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Transform3D"
 import "graphics.gd/variant/Vector3"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1327,8 +1328,8 @@ func (self class) ToGlobal(local_point Vector3.XYZ) Vector3.XYZ { //gd:Node3D.to
 	frame.Free()
 	return ret
 }
-func (self Instance) OnVisibilityChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("visibility_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnVisibilityChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "visibility_changed", cb, options...)
 }
 
 func (self class) AsNode3D() Advanced            { return *((*Advanced)(unsafe.Pointer(&self))) }
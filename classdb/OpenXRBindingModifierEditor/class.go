@@ -30,6 +30,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -141,8 +142,8 @@ func (self class) Setup(action_map [1]gdclass.OpenXRActionMap, binding_modifier
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.OpenXRBindingModifierEditor.Bind_setup, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnBindingModifierRemoved(cb func(binding_modifier_editor Object.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("binding_modifier_removed"), gd.NewCallable(cb), 0)
+func (self Instance) OnBindingModifierRemoved(cb func(binding_modifier_editor Object.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "binding_modifier_removed", cb, options...)
 }
 
 func (self class) AsOpenXRBindingModifierEditor() Advanced {
@@ -26,6 +26,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector3"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -624,36 +625,36 @@ func (self class) SetVrsStrength(strength float64) { //gd:OpenXRInterface.set_vr
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.OpenXRInterface.Bind_set_vrs_strength, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnSessionBegun(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("session_begun"), gd.NewCallable(cb), 0)
+func (self Instance) OnSessionBegun(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "session_begun", cb, options...)
 }
 
-func (self Instance) OnSessionStopping(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("session_stopping"), gd.NewCallable(cb), 0)
+func (self Instance) OnSessionStopping(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "session_stopping", cb, options...)
 }
 
-func (self Instance) OnSessionFocussed(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("session_focussed"), gd.NewCallable(cb), 0)
+func (self Instance) OnSessionFocussed(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "session_focussed", cb, options...)
 }
 
-func (self Instance) OnSessionVisible(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("session_visible"), gd.NewCallable(cb), 0)
+func (self Instance) OnSessionVisible(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "session_visible", cb, options...)
 }
 
-func (self Instance) OnSessionLossPending(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("session_loss_pending"), gd.NewCallable(cb), 0)
+func (self Instance) OnSessionLossPending(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "session_loss_pending", cb, options...)
 }
 
-func (self Instance) OnInstanceExiting(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("instance_exiting"), gd.NewCallable(cb), 0)
+func (self Instance) OnInstanceExiting(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "instance_exiting", cb, options...)
 }
 
-func (self Instance) OnPoseRecentered(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("pose_recentered"), gd.NewCallable(cb), 0)
+func (self Instance) OnPoseRecentered(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "pose_recentered", cb, options...)
 }
 
-func (self Instance) OnRefreshRateChanged(cb func(refresh_rate Float.X)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("refresh_rate_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnRefreshRateChanged(cb func(refresh_rate Float.X), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "refresh_rate_changed", cb, options...)
 }
 
 func (self class) AsOpenXRInterface() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -30,6 +30,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -780,12 +781,12 @@ func (self class) SetDisableShortcuts(disabled bool) { //gd:OptionButton.set_dis
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.OptionButton.Bind_set_disable_shortcuts, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnItemSelected(cb func(index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("item_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnItemSelected(cb func(index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "item_selected", cb, options...)
 }
 
-func (self Instance) OnItemFocused(cb func(index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("item_focused"), gd.NewCallable(cb), 0)
+func (self Instance) OnItemFocused(cb func(index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "item_focused", cb, options...)
 }
 
 func (self class) AsOptionButton() Advanced          { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -29,6 +29,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector3"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1959,8 +1960,8 @@ func (self class) GetCollisionBounce() float64 { //gd:ParticleProcessMaterial.ge
 	frame.Free()
 	return ret
 }
-func (self Instance) OnEmissionShapeChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("emission_shape_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnEmissionShapeChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "emission_shape_changed", cb, options...)
 }
 
 func (self class) AsParticleProcessMaterial() Advanced { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -26,6 +26,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -125,8 +126,8 @@ func (self class) GetCurve() [1]gdclass.Curve3D { //gd:Path3D.get_curve
 	frame.Free()
 	return ret
 }
-func (self Instance) OnCurveChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("curve_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnCurveChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "curve_changed", cb, options...)
 }
 
 func (self class) AsPath3D() Advanced                { return *((*Advanced)(unsafe.Pointer(&self))) }
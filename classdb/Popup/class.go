@@ -26,6 +26,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -98,8 +99,8 @@ func New() Instance {
 	return casted
 }
 
-func (self Instance) OnPopupHide(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("popup_hide"), gd.NewCallable(cb), 0)
+func (self Instance) OnPopupHide(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "popup_hide", cb, options...)
 }
 
 func (self class) AsPopup() Advanced                 { return *((*Advanced)(unsafe.Pointer(&self))) }
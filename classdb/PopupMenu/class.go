@@ -34,6 +34,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -2072,20 +2073,20 @@ func (self class) GetSystemMenu() NativeMenu.SystemMenus { //gd:PopupMenu.get_sy
 	frame.Free()
 	return ret
 }
-func (self Instance) OnIdPressed(cb func(id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("id_pressed"), gd.NewCallable(cb), 0)
+func (self Instance) OnIdPressed(cb func(id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "id_pressed", cb, options...)
 }
 
-func (self Instance) OnIdFocused(cb func(id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("id_focused"), gd.NewCallable(cb), 0)
+func (self Instance) OnIdFocused(cb func(id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "id_focused", cb, options...)
 }
 
-func (self Instance) OnIndexPressed(cb func(index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("index_pressed"), gd.NewCallable(cb), 0)
+func (self Instance) OnIndexPressed(cb func(index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "index_pressed", cb, options...)
 }
 
-func (self Instance) OnMenuChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("menu_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnMenuChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "menu_changed", cb, options...)
 }
 
 func (self class) AsPopupMenu() Advanced             { return *((*Advanced)(unsafe.Pointer(&self))) }
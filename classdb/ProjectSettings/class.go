@@ -24,6 +24,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -684,8 +685,9 @@ func (self class) SaveCustom(file String.Readable) Error.Code { //gd:ProjectSett
 	frame.Free()
 	return ret
 }
-func OnSettingsChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("settings_changed"), gd.NewCallable(cb), 0)
+func OnSettingsChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "settings_changed", cb, options...)
 }
 
 func (self class) Virtual(name string) reflect.Value {
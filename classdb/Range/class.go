@@ -26,6 +26,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -458,12 +459,12 @@ func (self class) Unshare() { //gd:Range.unshare
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.Range.Bind_unshare, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnValueChanged(cb func(value Float.X)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("value_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnValueChanged(cb func(value Float.X), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "value_changed", cb, options...)
 }
 
-func (self Instance) OnChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "changed", cb, options...)
 }
 
 func (self class) AsRange() Advanced                   { return *((*Advanced)(unsafe.Pointer(&self))) }
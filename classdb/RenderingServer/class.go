@@ -38,6 +38,7 @@ import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector2i"
 import "graphics.gd/variant/Vector3"
 import "graphics.gd/variant/Vector3i"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -11827,12 +11828,14 @@ func (self class) HasFeature(feature Features) bool { //gd:RenderingServer.has_f
 	frame.Free()
 	return ret
 }
-func OnFramePreDraw(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("frame_pre_draw"), gd.NewCallable(cb), 0)
+func OnFramePreDraw(cb func(), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "frame_pre_draw", cb, options...)
 }
 
-func OnFramePostDraw(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("frame_post_draw"), gd.NewCallable(cb), 0)
+func OnFramePostDraw(cb func(), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "frame_post_draw", cb, options...)
 }
 
 func (self class) Virtual(name string) reflect.Value {
@@ -23,6 +23,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -611,12 +612,12 @@ func (self class) Duplicate(subresources bool) [1]gdclass.Resource { //gd:Resour
 	frame.Free()
 	return ret
 }
-func (self Instance) OnChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "changed", cb, options...)
 }
 
-func (self Instance) OnSetupLocalToSceneRequested(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("setup_local_to_scene_requested"), gd.NewCallable(cb), 0)
+func (self Instance) OnSetupLocalToSceneRequested(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "setup_local_to_scene_requested", cb, options...)
 }
 
 func (self class) AsResource() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -37,6 +37,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector2i"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -2568,20 +2569,20 @@ func (self class) MenuOption(option int64) { //gd:RichTextLabel.menu_option
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.RichTextLabel.Bind_menu_option, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnMetaClicked(cb func(meta any)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("meta_clicked"), gd.NewCallable(cb), 0)
+func (self Instance) OnMetaClicked(cb func(meta any), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "meta_clicked", cb, options...)
 }
 
-func (self Instance) OnMetaHoverStarted(cb func(meta any)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("meta_hover_started"), gd.NewCallable(cb), 0)
+func (self Instance) OnMetaHoverStarted(cb func(meta any), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "meta_hover_started", cb, options...)
 }
 
-func (self Instance) OnMetaHoverEnded(cb func(meta any)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("meta_hover_ended"), gd.NewCallable(cb), 0)
+func (self Instance) OnMetaHoverEnded(cb func(meta any), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "meta_hover_ended", cb, options...)
 }
 
-func (self Instance) OnFinished(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("finished"), gd.NewCallable(cb), 0)
+func (self Instance) OnFinished(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "finished", cb, options...)
 }
 
 func (self class) AsRichTextLabel() Advanced           { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -31,6 +31,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1061,24 +1062,24 @@ func (self class) GetCollidingBodies() Array.Contains[[1]gdclass.Node2D] { //gd:
 	frame.Free()
 	return ret
 }
-func (self Instance) OnBodyShapeEntered(cb func(body_rid RID.Any, body Node.Instance, body_shape_index int, local_shape_index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("body_shape_entered"), gd.NewCallable(cb), 0)
+func (self Instance) OnBodyShapeEntered(cb func(body_rid RID.Any, body Node.Instance, body_shape_index int, local_shape_index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "body_shape_entered", cb, options...)
 }
 
-func (self Instance) OnBodyShapeExited(cb func(body_rid RID.Any, body Node.Instance, body_shape_index int, local_shape_index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("body_shape_exited"), gd.NewCallable(cb), 0)
+func (self Instance) OnBodyShapeExited(cb func(body_rid RID.Any, body Node.Instance, body_shape_index int, local_shape_index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "body_shape_exited", cb, options...)
 }
 
-func (self Instance) OnBodyEntered(cb func(body Node.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("body_entered"), gd.NewCallable(cb), 0)
+func (self Instance) OnBodyEntered(cb func(body Node.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "body_entered", cb, options...)
 }
 
-func (self Instance) OnBodyExited(cb func(body Node.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("body_exited"), gd.NewCallable(cb), 0)
+func (self Instance) OnBodyExited(cb func(body Node.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "body_exited", cb, options...)
 }
 
-func (self Instance) OnSleepingStateChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("sleeping_state_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnSleepingStateChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "sleeping_state_changed", cb, options...)
 }
 
 func (self class) AsRigidBody2D() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
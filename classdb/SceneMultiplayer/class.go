@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -452,16 +453,16 @@ func (self class) SetMaxDeltaPacketSize(size int64) { //gd:SceneMultiplayer.set_
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.SceneMultiplayer.Bind_set_max_delta_packet_size, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnPeerAuthenticating(cb func(id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("peer_authenticating"), gd.NewCallable(cb), 0)
+func (self Instance) OnPeerAuthenticating(cb func(id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "peer_authenticating", cb, options...)
 }
 
-func (self Instance) OnPeerAuthenticationFailed(cb func(id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("peer_authentication_failed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPeerAuthenticationFailed(cb func(id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "peer_authentication_failed", cb, options...)
 }
 
-func (self Instance) OnPeerPacket(cb func(id int, packet []byte)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("peer_packet"), gd.NewCallable(cb), 0)
+func (self Instance) OnPeerPacket(cb func(id int, packet []byte), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "peer_packet", cb, options...)
 }
 
 func (self class) AsSceneMultiplayer() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
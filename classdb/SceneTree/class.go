@@ -30,6 +30,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1033,36 +1034,36 @@ func (self class) IsMultiplayerPollEnabled() bool { //gd:SceneTree.is_multiplaye
 	frame.Free()
 	return ret
 }
-func (self Instance) OnTreeChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tree_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTreeChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tree_changed", cb, options...)
 }
 
-func (self Instance) OnTreeProcessModeChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tree_process_mode_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTreeProcessModeChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tree_process_mode_changed", cb, options...)
 }
 
-func (self Instance) OnNodeAdded(cb func(node Node.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("node_added"), gd.NewCallable(cb), 0)
+func (self Instance) OnNodeAdded(cb func(node Node.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "node_added", cb, options...)
 }
 
-func (self Instance) OnNodeRemoved(cb func(node Node.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("node_removed"), gd.NewCallable(cb), 0)
+func (self Instance) OnNodeRemoved(cb func(node Node.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "node_removed", cb, options...)
 }
 
-func (self Instance) OnNodeRenamed(cb func(node Node.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("node_renamed"), gd.NewCallable(cb), 0)
+func (self Instance) OnNodeRenamed(cb func(node Node.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "node_renamed", cb, options...)
 }
 
-func (self Instance) OnNodeConfigurationWarningChanged(cb func(node Node.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("node_configuration_warning_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnNodeConfigurationWarningChanged(cb func(node Node.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "node_configuration_warning_changed", cb, options...)
 }
 
-func (self Instance) OnProcessFrame(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("process_frame"), gd.NewCallable(cb), 0)
+func (self Instance) OnProcessFrame(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "process_frame", cb, options...)
 }
 
-func (self Instance) OnPhysicsFrame(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("physics_frame"), gd.NewCallable(cb), 0)
+func (self Instance) OnPhysicsFrame(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "physics_frame", cb, options...)
 }
 
 func (self class) AsSceneTree() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -1,8 +1,12 @@
 package SceneTree
 
 import (
+	"time"
+
 	"graphics.gd/classdb/Engine"
 	"graphics.gd/classdb/Node"
+	gd "graphics.gd/internal"
+	"graphics.gd/variant/Float"
 	"graphics.gd/variant/Object"
 )
 
@@ -21,3 +25,23 @@ func AddNamed(name string, node Node.Any) {
 		tree.Root().AsNode().AddChild(node)
 	}
 }
+
+// After returns a channel that is closed once the given number of seconds have passed
+// in the scene tree, such that the timer respects [Instance.Paused] and the time scale,
+// like GDScript's await get_tree().create_timer(seconds).timeout. Falls back to a wall
+// clock timer if the main loop is not a SceneTree. Must be called from the main thread,
+// receiving from the channel on the main thread blocks the engine, such that the timer
+// never fires, use startup.Wait from the main function stepped by startup.Rendering or
+// receive from a goroutine instead.
+func After(seconds Float.X) <-chan struct{} {
+	done := make(chan struct{})
+	tree, ok := Object.As[Instance](Engine.GetMainLoop())
+	if !ok {
+		time.AfterFunc(time.Duration(float64(seconds)*float64(time.Second)), func() { close(done) })
+		return done
+	}
+	Expanded(tree).CreateTimer(seconds, false, false, false).AsObject()[0].Connect(gd.NewStringName("timeout"), gd.NewCallable(func() {
+		close(done)
+	}), 4) // CONNECT_ONE_SHOT
+	return done
+}
@@ -29,6 +29,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -153,8 +154,8 @@ func (self class) Config(inherits String.Readable, path String.Readable, built_i
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.ScriptCreateDialog.Bind_config, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnScriptCreated(cb func(script Script.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("script_created"), gd.NewCallable(cb), 0)
+func (self Instance) OnScriptCreated(cb func(script Script.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "script_created", cb, options...)
 }
 
 func (self class) AsScriptCreateDialog() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -31,6 +31,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -366,12 +367,12 @@ func (self class) UpdateDocsFromScript(script [1]gdclass.Script) { //gd:ScriptEd
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.ScriptEditor.Bind_update_docs_from_script, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnEditorScriptChanged(cb func(script Script.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("editor_script_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnEditorScriptChanged(cb func(script Script.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "editor_script_changed", cb, options...)
 }
 
-func (self Instance) OnScriptClose(cb func(script Script.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("script_close"), gd.NewCallable(cb), 0)
+func (self Instance) OnScriptClose(cb func(script Script.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "script_close", cb, options...)
 }
 
 func (self class) AsScriptEditor() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -30,6 +30,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -140,44 +141,44 @@ func (self class) AddSyntaxHighlighter(highlighter [1]gdclass.EditorSyntaxHighli
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.ScriptEditorBase.Bind_add_syntax_highlighter, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnNameChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("name_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnNameChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "name_changed", cb, options...)
 }
 
-func (self Instance) OnEditedScriptChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("edited_script_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnEditedScriptChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "edited_script_changed", cb, options...)
 }
 
-func (self Instance) OnRequestHelp(cb func(topic string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("request_help"), gd.NewCallable(cb), 0)
+func (self Instance) OnRequestHelp(cb func(topic string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "request_help", cb, options...)
 }
 
-func (self Instance) OnRequestOpenScriptAtLine(cb func(script Object.Instance, line int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("request_open_script_at_line"), gd.NewCallable(cb), 0)
+func (self Instance) OnRequestOpenScriptAtLine(cb func(script Object.Instance, line int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "request_open_script_at_line", cb, options...)
 }
 
-func (self Instance) OnRequestSaveHistory(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("request_save_history"), gd.NewCallable(cb), 0)
+func (self Instance) OnRequestSaveHistory(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "request_save_history", cb, options...)
 }
 
-func (self Instance) OnRequestSavePreviousState(cb func(state map[any]any)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("request_save_previous_state"), gd.NewCallable(cb), 0)
+func (self Instance) OnRequestSavePreviousState(cb func(state map[any]any), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "request_save_previous_state", cb, options...)
 }
 
-func (self Instance) OnGoToHelp(cb func(what string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("go_to_help"), gd.NewCallable(cb), 0)
+func (self Instance) OnGoToHelp(cb func(what string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "go_to_help", cb, options...)
 }
 
-func (self Instance) OnSearchInFilesRequested(cb func(text string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("search_in_files_requested"), gd.NewCallable(cb), 0)
+func (self Instance) OnSearchInFilesRequested(cb func(text string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "search_in_files_requested", cb, options...)
 }
 
-func (self Instance) OnReplaceInFilesRequested(cb func(text string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("replace_in_files_requested"), gd.NewCallable(cb), 0)
+func (self Instance) OnReplaceInFilesRequested(cb func(text string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "replace_in_files_requested", cb, options...)
 }
 
-func (self Instance) OnGoToMethod(cb func(script Object.Instance, method string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("go_to_method"), gd.NewCallable(cb), 0)
+func (self Instance) OnGoToMethod(cb func(script Object.Instance, method string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "go_to_method", cb, options...)
 }
 
 func (self class) AsScriptEditorBase() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
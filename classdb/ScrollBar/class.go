@@ -27,6 +27,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -125,8 +126,8 @@ func (self class) GetCustomStep() float64 { //gd:ScrollBar.get_custom_step
 	frame.Free()
 	return ret
 }
-func (self Instance) OnScrolling(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("scrolling"), gd.NewCallable(cb), 0)
+func (self Instance) OnScrolling(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "scrolling", cb, options...)
 }
 
 func (self class) AsScrollBar() Advanced               { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -29,6 +29,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -418,12 +419,12 @@ func (self class) GetDrawFocusBorder() bool { //gd:ScrollContainer.get_draw_focu
 	frame.Free()
 	return ret
 }
-func (self Instance) OnScrollStarted(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("scroll_started"), gd.NewCallable(cb), 0)
+func (self Instance) OnScrollStarted(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "scroll_started", cb, options...)
 }
 
-func (self Instance) OnScrollEnded(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("scroll_ended"), gd.NewCallable(cb), 0)
+func (self Instance) OnScrollEnded(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "scroll_ended", cb, options...)
 }
 
 func (self class) AsScrollContainer() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
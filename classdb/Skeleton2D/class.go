@@ -29,6 +29,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Transform2D"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -275,8 +276,8 @@ func (self class) GetBoneLocalPoseOverride(bone_idx int64) Transform2D.OriginXY
 	frame.Free()
 	return ret
 }
-func (self Instance) OnBoneSetupChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("bone_setup_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnBoneSetupChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "bone_setup_changed", cb, options...)
 }
 
 func (self class) AsSkeleton2D() Advanced            { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -30,6 +30,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Transform3D"
 import "graphics.gd/variant/Vector3"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1204,28 +1205,28 @@ func (self class) PhysicalBonesRemoveCollisionException(exception RID.Any) { //g
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.Skeleton3D.Bind_physical_bones_remove_collision_exception, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnRestUpdated(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("rest_updated"), gd.NewCallable(cb), 0)
+func (self Instance) OnRestUpdated(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "rest_updated", cb, options...)
 }
 
-func (self Instance) OnPoseUpdated(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("pose_updated"), gd.NewCallable(cb), 0)
+func (self Instance) OnPoseUpdated(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "pose_updated", cb, options...)
 }
 
-func (self Instance) OnSkeletonUpdated(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("skeleton_updated"), gd.NewCallable(cb), 0)
+func (self Instance) OnSkeletonUpdated(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "skeleton_updated", cb, options...)
 }
 
-func (self Instance) OnBoneEnabledChanged(cb func(bone_idx int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("bone_enabled_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnBoneEnabledChanged(cb func(bone_idx int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "bone_enabled_changed", cb, options...)
 }
 
-func (self Instance) OnBoneListChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("bone_list_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnBoneListChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "bone_list_changed", cb, options...)
 }
 
-func (self Instance) OnShowRestOnlyChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("show_rest_only_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnShowRestOnlyChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "show_rest_only_changed", cb, options...)
 }
 
 func (self class) AsSkeleton3D() Advanced            { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -26,6 +26,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -209,8 +210,8 @@ func (self class) GetInfluence() float64 { //gd:SkeletonModifier3D.get_influence
 	frame.Free()
 	return ret
 }
-func (self Instance) OnModificationProcessed(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("modification_processed"), gd.NewCallable(cb), 0)
+func (self Instance) OnModificationProcessed(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "modification_processed", cb, options...)
 }
 
 func (self class) AsSkeletonModifier3D() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -27,6 +27,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Transform3D"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -651,8 +652,8 @@ func (self class) SetRequired(bone_idx int64, required bool) { //gd:SkeletonProf
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.SkeletonProfile.Bind_set_required, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnProfileUpdated(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("profile_updated"), gd.NewCallable(cb), 0)
+func (self Instance) OnProfileUpdated(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "profile_updated", cb, options...)
 }
 
 func (self class) AsSkeletonProfile() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
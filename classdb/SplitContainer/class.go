@@ -27,6 +27,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -389,16 +390,16 @@ func (self class) GetDragAreaControl() [1]gdclass.Control { //gd:SplitContainer.
 	frame.Free()
 	return ret
 }
-func (self Instance) OnDragged(cb func(offset int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("dragged"), gd.NewCallable(cb), 0)
+func (self Instance) OnDragged(cb func(offset int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "dragged", cb, options...)
 }
 
-func (self Instance) OnDragStarted(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("drag_started"), gd.NewCallable(cb), 0)
+func (self Instance) OnDragStarted(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "drag_started", cb, options...)
 }
 
-func (self Instance) OnDragEnded(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("drag_ended"), gd.NewCallable(cb), 0)
+func (self Instance) OnDragEnded(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "drag_ended", cb, options...)
 }
 
 func (self class) AsSplitContainer() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -30,6 +30,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector2i"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -520,12 +521,12 @@ func (self class) GetRect() Rect2.PositionSize { //gd:Sprite2D.get_rect
 	frame.Free()
 	return ret
 }
-func (self Instance) OnFrameChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("frame_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnFrameChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "frame_changed", cb, options...)
 }
 
-func (self Instance) OnTextureChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("texture_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTextureChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "texture_changed", cb, options...)
 }
 
 func (self class) AsSprite2D() Advanced              { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -27,6 +27,7 @@ import "graphics.gd/variant/Rect2"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2i"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -222,8 +223,8 @@ func (self class) GetRect() Rect2.PositionSize { //gd:StatusIndicator.get_rect
 	frame.Free()
 	return ret
 }
-func (self Instance) OnPressed(cb func(mouse_button int, mouse_position Vector2i.XY)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("pressed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPressed(cb func(mouse_button int, mouse_position Vector2i.XY), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "pressed", cb, options...)
 }
 
 func (self class) AsStatusIndicator() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
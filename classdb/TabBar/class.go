@@ -29,6 +29,7 @@ import "graphics.gd/variant/Rect2"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1083,36 +1084,36 @@ func (self class) ClearTabs() { //gd:TabBar.clear_tabs
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.TabBar.Bind_clear_tabs, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnTabSelected(cb func(tab int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tab_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnTabSelected(cb func(tab int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tab_selected", cb, options...)
 }
 
-func (self Instance) OnTabChanged(cb func(tab int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tab_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTabChanged(cb func(tab int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tab_changed", cb, options...)
 }
 
-func (self Instance) OnTabClicked(cb func(tab int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tab_clicked"), gd.NewCallable(cb), 0)
+func (self Instance) OnTabClicked(cb func(tab int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tab_clicked", cb, options...)
 }
 
-func (self Instance) OnTabRmbClicked(cb func(tab int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tab_rmb_clicked"), gd.NewCallable(cb), 0)
+func (self Instance) OnTabRmbClicked(cb func(tab int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tab_rmb_clicked", cb, options...)
 }
 
-func (self Instance) OnTabClosePressed(cb func(tab int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tab_close_pressed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTabClosePressed(cb func(tab int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tab_close_pressed", cb, options...)
 }
 
-func (self Instance) OnTabButtonPressed(cb func(tab int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tab_button_pressed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTabButtonPressed(cb func(tab int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tab_button_pressed", cb, options...)
 }
 
-func (self Instance) OnTabHovered(cb func(tab int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tab_hovered"), gd.NewCallable(cb), 0)
+func (self Instance) OnTabHovered(cb func(tab int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tab_hovered", cb, options...)
 }
 
-func (self Instance) OnActiveTabRearranged(cb func(idx_to int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("active_tab_rearranged"), gd.NewCallable(cb), 0)
+func (self Instance) OnActiveTabRearranged(cb func(idx_to int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "active_tab_rearranged", cb, options...)
 }
 
 func (self class) AsTabBar() Advanced                  { return *((*Advanced)(unsafe.Pointer(&self))) }
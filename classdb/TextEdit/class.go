@@ -36,6 +36,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector2i"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -5109,32 +5110,32 @@ func (self class) GetSelectionColumn(caret_index int64) int64 { //gd:TextEdit.ge
 	frame.Free()
 	return ret
 }
-func (self Instance) OnTextSet(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("text_set"), gd.NewCallable(cb), 0)
+func (self Instance) OnTextSet(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "text_set", cb, options...)
 }
 
-func (self Instance) OnTextChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("text_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTextChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "text_changed", cb, options...)
 }
 
-func (self Instance) OnLinesEditedFrom(cb func(from_line int, to_line int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("lines_edited_from"), gd.NewCallable(cb), 0)
+func (self Instance) OnLinesEditedFrom(cb func(from_line int, to_line int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "lines_edited_from", cb, options...)
 }
 
-func (self Instance) OnCaretChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("caret_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnCaretChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "caret_changed", cb, options...)
 }
 
-func (self Instance) OnGutterClicked(cb func(line int, gutter int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("gutter_clicked"), gd.NewCallable(cb), 0)
+func (self Instance) OnGutterClicked(cb func(line int, gutter int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "gutter_clicked", cb, options...)
 }
 
-func (self Instance) OnGutterAdded(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("gutter_added"), gd.NewCallable(cb), 0)
+func (self Instance) OnGutterAdded(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "gutter_added", cb, options...)
 }
 
-func (self Instance) OnGutterRemoved(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("gutter_removed"), gd.NewCallable(cb), 0)
+func (self Instance) OnGutterRemoved(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "gutter_removed", cb, options...)
 }
 
 func (self class) AsTextEdit() Advanced                { return *((*Advanced)(unsafe.Pointer(&self))) }
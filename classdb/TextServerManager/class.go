@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -260,12 +261,14 @@ func (self class) GetPrimaryInterface() [1]gdclass.TextServer { //gd:TextServerM
 	frame.Free()
 	return ret
 }
-func OnInterfaceAdded(cb func(interface_name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("interface_added"), gd.NewCallable(cb), 0)
+func OnInterfaceAdded(cb func(interface_name string), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "interface_added", cb, options...)
 }
 
-func OnInterfaceRemoved(cb func(interface_name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("interface_removed"), gd.NewCallable(cb), 0)
+func OnInterfaceRemoved(cb func(interface_name string), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "interface_removed", cb, options...)
 }
 
 func (self class) Virtual(name string) reflect.Value {
@@ -28,6 +28,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -285,8 +286,9 @@ func (self class) GetFallbackStylebox() [1]gdclass.StyleBox { //gd:ThemeDB.get_f
 	frame.Free()
 	return ret
 }
-func OnFallbackChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("fallback_changed"), gd.NewCallable(cb), 0)
+func OnFallbackChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "fallback_changed", cb, options...)
 }
 
 func (self class) Virtual(name string) reflect.Value {
@@ -30,6 +30,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector2i"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1100,8 +1101,8 @@ func (self class) GetCustomDataByLayerId(layer_id int64) variant.Any { //gd:Tile
 	frame.Free()
 	return ret
 }
-func (self Instance) OnChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "changed", cb, options...)
 }
 
 func (self class) AsTileData() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
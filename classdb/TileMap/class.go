@@ -33,6 +33,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector2i"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1652,8 +1653,8 @@ func (self class) GetNeighborCell(coords Vector2i.XY, neighbor TileSet.CellNeigh
 	frame.Free()
 	return ret
 }
-func (self Instance) OnChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "changed", cb, options...)
 }
 
 func (self class) AsTileMap() Advanced               { return *((*Advanced)(unsafe.Pointer(&self))) }
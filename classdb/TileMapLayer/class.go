@@ -32,6 +32,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector2i"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1254,8 +1255,8 @@ func (self class) GetNavigationVisibilityMode() DebugVisibilityMode { //gd:TileM
 	frame.Free()
 	return ret
 }
-func (self Instance) OnChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "changed", cb, options...)
 }
 
 func (self class) AsTileMapLayer() Advanced          { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -24,6 +24,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -350,8 +351,8 @@ func (self class) GetTimerProcessCallback() TimerProcessCallback { //gd:Timer.ge
 	frame.Free()
 	return ret
 }
-func (self Instance) OnTimeout(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("timeout"), gd.NewCallable(cb), 0)
+func (self Instance) OnTimeout(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "timeout", cb, options...)
 }
 
 func (self class) AsTimer() Advanced             { return *((*Advanced)(unsafe.Pointer(&self))) }
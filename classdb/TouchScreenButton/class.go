@@ -29,6 +29,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -365,12 +366,12 @@ func (self class) IsPressed() bool { //gd:TouchScreenButton.is_pressed
 	frame.Free()
 	return ret
 }
-func (self Instance) OnPressed(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("pressed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPressed(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "pressed", cb, options...)
 }
 
-func (self Instance) OnReleased(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("released"), gd.NewCallable(cb), 0)
+func (self Instance) OnReleased(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "released", cb, options...)
 }
 
 func (self class) AsTouchScreenButton() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
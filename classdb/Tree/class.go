@@ -30,6 +30,7 @@ import "graphics.gd/variant/Rect2"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1349,64 +1350,64 @@ func (self class) IsAutoTooltipEnabled() bool { //gd:Tree.is_auto_tooltip_enable
 	frame.Free()
 	return ret
 }
-func (self Instance) OnItemSelected(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("item_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnItemSelected(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "item_selected", cb, options...)
 }
 
-func (self Instance) OnCellSelected(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("cell_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnCellSelected(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "cell_selected", cb, options...)
 }
 
-func (self Instance) OnMultiSelected(cb func(item TreeItem.Instance, column int, selected bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("multi_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnMultiSelected(cb func(item TreeItem.Instance, column int, selected bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "multi_selected", cb, options...)
 }
 
-func (self Instance) OnItemMouseSelected(cb func(mouse_position Vector2.XY, mouse_button_index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("item_mouse_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnItemMouseSelected(cb func(mouse_position Vector2.XY, mouse_button_index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "item_mouse_selected", cb, options...)
 }
 
-func (self Instance) OnEmptyClicked(cb func(click_position Vector2.XY, mouse_button_index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("empty_clicked"), gd.NewCallable(cb), 0)
+func (self Instance) OnEmptyClicked(cb func(click_position Vector2.XY, mouse_button_index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "empty_clicked", cb, options...)
 }
 
-func (self Instance) OnItemEdited(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("item_edited"), gd.NewCallable(cb), 0)
+func (self Instance) OnItemEdited(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "item_edited", cb, options...)
 }
 
-func (self Instance) OnCustomItemClicked(cb func(mouse_button_index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("custom_item_clicked"), gd.NewCallable(cb), 0)
+func (self Instance) OnCustomItemClicked(cb func(mouse_button_index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "custom_item_clicked", cb, options...)
 }
 
-func (self Instance) OnItemIconDoubleClicked(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("item_icon_double_clicked"), gd.NewCallable(cb), 0)
+func (self Instance) OnItemIconDoubleClicked(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "item_icon_double_clicked", cb, options...)
 }
 
-func (self Instance) OnItemCollapsed(cb func(item TreeItem.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("item_collapsed"), gd.NewCallable(cb), 0)
+func (self Instance) OnItemCollapsed(cb func(item TreeItem.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "item_collapsed", cb, options...)
 }
 
-func (self Instance) OnCheckPropagatedToItem(cb func(item TreeItem.Instance, column int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("check_propagated_to_item"), gd.NewCallable(cb), 0)
+func (self Instance) OnCheckPropagatedToItem(cb func(item TreeItem.Instance, column int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "check_propagated_to_item", cb, options...)
 }
 
-func (self Instance) OnButtonClicked(cb func(item TreeItem.Instance, column int, id int, mouse_button_index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("button_clicked"), gd.NewCallable(cb), 0)
+func (self Instance) OnButtonClicked(cb func(item TreeItem.Instance, column int, id int, mouse_button_index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "button_clicked", cb, options...)
 }
 
-func (self Instance) OnCustomPopupEdited(cb func(arrow_clicked bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("custom_popup_edited"), gd.NewCallable(cb), 0)
+func (self Instance) OnCustomPopupEdited(cb func(arrow_clicked bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "custom_popup_edited", cb, options...)
 }
 
-func (self Instance) OnItemActivated(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("item_activated"), gd.NewCallable(cb), 0)
+func (self Instance) OnItemActivated(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "item_activated", cb, options...)
 }
 
-func (self Instance) OnColumnTitleClicked(cb func(column int, mouse_button_index int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("column_title_clicked"), gd.NewCallable(cb), 0)
+func (self Instance) OnColumnTitleClicked(cb func(column int, mouse_button_index int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "column_title_clicked", cb, options...)
 }
 
-func (self Instance) OnNothingSelected(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("nothing_selected"), gd.NewCallable(cb), 0)
+func (self Instance) OnNothingSelected(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "nothing_selected", cb, options...)
 }
 
 func (self class) AsTree() Advanced                    { return *((*Advanced)(unsafe.Pointer(&self))) }
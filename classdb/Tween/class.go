@@ -26,6 +26,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -1110,16 +1111,16 @@ func (self class) InterpolateValue(initial_value variant.Any, delta_value varian
 	frame.Free()
 	return ret
 }
-func (self Instance) OnStepFinished(cb func(idx int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("step_finished"), gd.NewCallable(cb), 0)
+func (self Instance) OnStepFinished(cb func(idx int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "step_finished", cb, options...)
 }
 
-func (self Instance) OnLoopFinished(cb func(loop_count int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("loop_finished"), gd.NewCallable(cb), 0)
+func (self Instance) OnLoopFinished(cb func(loop_count int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "loop_finished", cb, options...)
 }
 
-func (self Instance) OnFinished(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("finished"), gd.NewCallable(cb), 0)
+func (self Instance) OnFinished(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "finished", cb, options...)
 }
 
 func (self class) AsTween() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
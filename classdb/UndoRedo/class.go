@@ -23,6 +23,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -697,8 +698,8 @@ func (self class) Undo() bool { //gd:UndoRedo.undo
 	frame.Free()
 	return ret
 }
-func (self Instance) OnVersionChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("version_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnVersionChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "version_changed", cb, options...)
 }
 
 func (self class) AsUndoRedo() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
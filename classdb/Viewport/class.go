@@ -37,6 +37,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Transform2D"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -2086,12 +2087,12 @@ func (self class) GetVrsTexture() [1]gdclass.Texture2D { //gd:Viewport.get_vrs_t
 	frame.Free()
 	return ret
 }
-func (self Instance) OnSizeChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("size_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnSizeChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "size_changed", cb, options...)
 }
 
-func (self Instance) OnGuiFocusChanged(cb func(node Control.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("gui_focus_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnGuiFocusChanged(cb func(node Control.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "gui_focus_changed", cb, options...)
 }
 
 func (self class) AsViewport() Advanced          { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -27,6 +27,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -135,12 +136,12 @@ func (self class) IsOnScreen() bool { //gd:VisibleOnScreenNotifier3D.is_on_scree
 	frame.Free()
 	return ret
 }
-func (self Instance) OnScreenEntered(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("screen_entered"), gd.NewCallable(cb), 0)
+func (self Instance) OnScreenEntered(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "screen_entered", cb, options...)
 }
 
-func (self Instance) OnScreenExited(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("screen_exited"), gd.NewCallable(cb), 0)
+func (self Instance) OnScreenExited(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "screen_exited", cb, options...)
 }
 
 func (self class) AsVisibleOnScreenNotifier3D() Advanced {
@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -144,8 +145,8 @@ func (self class) GetInputRealName() String.Readable { //gd:VisualShaderNodeInpu
 	frame.Free()
 	return ret
 }
-func (self Instance) OnInputTypeChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("input_type_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnInputTypeChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "input_type_changed", cb, options...)
 }
 
 func (self class) AsVisualShaderNodeInput() Advanced    { return *((*Advanced)(unsafe.Pointer(&self))) }
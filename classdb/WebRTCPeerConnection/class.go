@@ -24,6 +24,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -476,16 +477,16 @@ func (self class) GetSignalingState() SignalingState { //gd:WebRTCPeerConnection
 	frame.Free()
 	return ret
 }
-func (self Instance) OnSessionDescriptionCreated(cb func(atype string, sdp string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("session_description_created"), gd.NewCallable(cb), 0)
+func (self Instance) OnSessionDescriptionCreated(cb func(atype string, sdp string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "session_description_created", cb, options...)
 }
 
-func (self Instance) OnIceCandidateCreated(cb func(media string, index int, name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("ice_candidate_created"), gd.NewCallable(cb), 0)
+func (self Instance) OnIceCandidateCreated(cb func(media string, index int, name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "ice_candidate_created", cb, options...)
 }
 
-func (self Instance) OnDataChannelReceived(cb func(channel WebRTCDataChannel.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("data_channel_received"), gd.NewCallable(cb), 0)
+func (self Instance) OnDataChannelReceived(cb func(channel WebRTCDataChannel.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "data_channel_received", cb, options...)
 }
 
 func (self class) AsWebRTCPeerConnection() Advanced    { return *((*Advanced)(unsafe.Pointer(&self))) }
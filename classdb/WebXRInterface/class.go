@@ -25,6 +25,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -504,56 +505,56 @@ func (self class) GetAvailableDisplayRefreshRates() Array.Any { //gd:WebXRInterf
 	frame.Free()
 	return ret
 }
-func (self Instance) OnSessionSupported(cb func(session_mode string, supported bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("session_supported"), gd.NewCallable(cb), 0)
+func (self Instance) OnSessionSupported(cb func(session_mode string, supported bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "session_supported", cb, options...)
 }
 
-func (self Instance) OnSessionStarted(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("session_started"), gd.NewCallable(cb), 0)
+func (self Instance) OnSessionStarted(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "session_started", cb, options...)
 }
 
-func (self Instance) OnSessionEnded(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("session_ended"), gd.NewCallable(cb), 0)
+func (self Instance) OnSessionEnded(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "session_ended", cb, options...)
 }
 
-func (self Instance) OnSessionFailed(cb func(message string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("session_failed"), gd.NewCallable(cb), 0)
+func (self Instance) OnSessionFailed(cb func(message string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "session_failed", cb, options...)
 }
 
-func (self Instance) OnSelectstart(cb func(input_source_id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("selectstart"), gd.NewCallable(cb), 0)
+func (self Instance) OnSelectstart(cb func(input_source_id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "selectstart", cb, options...)
 }
 
-func (self Instance) OnSelect(cb func(input_source_id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("select"), gd.NewCallable(cb), 0)
+func (self Instance) OnSelect(cb func(input_source_id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "select", cb, options...)
 }
 
-func (self Instance) OnSelectend(cb func(input_source_id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("selectend"), gd.NewCallable(cb), 0)
+func (self Instance) OnSelectend(cb func(input_source_id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "selectend", cb, options...)
 }
 
-func (self Instance) OnSqueezestart(cb func(input_source_id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("squeezestart"), gd.NewCallable(cb), 0)
+func (self Instance) OnSqueezestart(cb func(input_source_id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "squeezestart", cb, options...)
 }
 
-func (self Instance) OnSqueeze(cb func(input_source_id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("squeeze"), gd.NewCallable(cb), 0)
+func (self Instance) OnSqueeze(cb func(input_source_id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "squeeze", cb, options...)
 }
 
-func (self Instance) OnSqueezeend(cb func(input_source_id int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("squeezeend"), gd.NewCallable(cb), 0)
+func (self Instance) OnSqueezeend(cb func(input_source_id int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "squeezeend", cb, options...)
 }
 
-func (self Instance) OnVisibilityStateChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("visibility_state_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnVisibilityStateChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "visibility_state_changed", cb, options...)
 }
 
-func (self Instance) OnReferenceSpaceReset(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("reference_space_reset"), gd.NewCallable(cb), 0)
+func (self Instance) OnReferenceSpaceReset(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "reference_space_reset", cb, options...)
 }
 
-func (self Instance) OnDisplayRefreshRateChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("display_refresh_rate_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnDisplayRefreshRateChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "display_refresh_rate_changed", cb, options...)
 }
 
 func (self class) AsWebXRInterface() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
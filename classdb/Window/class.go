@@ -35,6 +35,7 @@ import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector2i"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -2580,60 +2581,60 @@ func (self class) PopupExclusiveCenteredClamped(from_node [1]gdclass.Node, minsi
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.Window.Bind_popup_exclusive_centered_clamped, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnWindowInput(cb func(event InputEvent.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("window_input"), gd.NewCallable(cb), 0)
+func (self Instance) OnWindowInput(cb func(event InputEvent.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "window_input", cb, options...)
 }
 
-func (self Instance) OnFilesDropped(cb func(files []string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("files_dropped"), gd.NewCallable(cb), 0)
+func (self Instance) OnFilesDropped(cb func(files []string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "files_dropped", cb, options...)
 }
 
-func (self Instance) OnMouseEntered(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("mouse_entered"), gd.NewCallable(cb), 0)
+func (self Instance) OnMouseEntered(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "mouse_entered", cb, options...)
 }
 
-func (self Instance) OnMouseExited(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("mouse_exited"), gd.NewCallable(cb), 0)
+func (self Instance) OnMouseExited(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "mouse_exited", cb, options...)
 }
 
-func (self Instance) OnFocusEntered(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("focus_entered"), gd.NewCallable(cb), 0)
+func (self Instance) OnFocusEntered(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "focus_entered", cb, options...)
 }
 
-func (self Instance) OnFocusExited(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("focus_exited"), gd.NewCallable(cb), 0)
+func (self Instance) OnFocusExited(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "focus_exited", cb, options...)
 }
 
-func (self Instance) OnCloseRequested(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("close_requested"), gd.NewCallable(cb), 0)
+func (self Instance) OnCloseRequested(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "close_requested", cb, options...)
 }
 
-func (self Instance) OnGoBackRequested(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("go_back_requested"), gd.NewCallable(cb), 0)
+func (self Instance) OnGoBackRequested(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "go_back_requested", cb, options...)
 }
 
-func (self Instance) OnVisibilityChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("visibility_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnVisibilityChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "visibility_changed", cb, options...)
 }
 
-func (self Instance) OnAboutToPopup(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("about_to_popup"), gd.NewCallable(cb), 0)
+func (self Instance) OnAboutToPopup(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "about_to_popup", cb, options...)
 }
 
-func (self Instance) OnThemeChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("theme_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnThemeChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "theme_changed", cb, options...)
 }
 
-func (self Instance) OnDpiChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("dpi_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnDpiChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "dpi_changed", cb, options...)
 }
 
-func (self Instance) OnTitlebarChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("titlebar_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTitlebarChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "titlebar_changed", cb, options...)
 }
 
-func (self Instance) OnTitleChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("title_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTitleChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "title_changed", cb, options...)
 }
 
 func (self class) AsWindow() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
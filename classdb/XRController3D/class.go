@@ -28,6 +28,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Vector2"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -206,24 +207,24 @@ func (self class) GetTrackerHand() XRPositionalTracker.TrackerHand { //gd:XRCont
 	frame.Free()
 	return ret
 }
-func (self Instance) OnButtonPressed(cb func(name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("button_pressed"), gd.NewCallable(cb), 0)
+func (self Instance) OnButtonPressed(cb func(name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "button_pressed", cb, options...)
 }
 
-func (self Instance) OnButtonReleased(cb func(name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("button_released"), gd.NewCallable(cb), 0)
+func (self Instance) OnButtonReleased(cb func(name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "button_released", cb, options...)
 }
 
-func (self Instance) OnInputFloatChanged(cb func(name string, value Float.X)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("input_float_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnInputFloatChanged(cb func(name string, value Float.X), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "input_float_changed", cb, options...)
 }
 
-func (self Instance) OnInputVector2Changed(cb func(name string, value Vector2.XY)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("input_vector2_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnInputVector2Changed(cb func(name string, value Vector2.XY), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "input_vector2_changed", cb, options...)
 }
 
-func (self Instance) OnProfileChanged(cb func(role string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("profile_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnProfileChanged(cb func(role string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "profile_changed", cb, options...)
 }
 
 func (self class) AsXRController3D() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
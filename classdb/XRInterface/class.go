@@ -27,6 +27,7 @@ import "graphics.gd/variant/String"
 import "graphics.gd/variant/Transform3D"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector3"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -669,8 +670,8 @@ func (self class) GetEnvironmentBlendMode() EnvironmentBlendMode { //gd:XRInterf
 	frame.Free()
 	return ret
 }
-func (self Instance) OnPlayAreaChanged(cb func(mode int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("play_area_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPlayAreaChanged(cb func(mode int), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "play_area_changed", cb, options...)
 }
 
 func (self class) AsXRInterface() Advanced         { return *((*Advanced)(unsafe.Pointer(&self))) }
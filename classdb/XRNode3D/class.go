@@ -26,6 +26,7 @@ import "graphics.gd/variant/Path"
 import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -271,8 +272,8 @@ func (self class) TriggerHapticPulse(action_name String.Readable, frequency floa
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.XRNode3D.Bind_trigger_haptic_pulse, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnTrackingChanged(cb func(tracking bool)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tracking_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnTrackingChanged(cb func(tracking bool), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tracking_changed", cb, options...)
 }
 
 func (self class) AsXRNode3D() Advanced              { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -28,6 +28,7 @@ import "graphics.gd/variant/String"
 import "graphics.gd/variant/Transform3D"
 import "graphics.gd/variant/Vector2"
 import "graphics.gd/variant/Vector3"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -281,32 +282,32 @@ func (self class) SetInput(name String.Name, value variant.Any) { //gd:XRPositio
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.XRPositionalTracker.Bind_set_input, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func (self Instance) OnPoseChanged(cb func(pose XRPose.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("pose_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnPoseChanged(cb func(pose XRPose.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "pose_changed", cb, options...)
 }
 
-func (self Instance) OnPoseLostTracking(cb func(pose XRPose.Instance)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("pose_lost_tracking"), gd.NewCallable(cb), 0)
+func (self Instance) OnPoseLostTracking(cb func(pose XRPose.Instance), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "pose_lost_tracking", cb, options...)
 }
 
-func (self Instance) OnButtonPressed(cb func(name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("button_pressed"), gd.NewCallable(cb), 0)
+func (self Instance) OnButtonPressed(cb func(name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "button_pressed", cb, options...)
 }
 
-func (self Instance) OnButtonReleased(cb func(name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("button_released"), gd.NewCallable(cb), 0)
+func (self Instance) OnButtonReleased(cb func(name string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "button_released", cb, options...)
 }
 
-func (self Instance) OnInputFloatChanged(cb func(name string, value Float.X)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("input_float_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnInputFloatChanged(cb func(name string, value Float.X), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "input_float_changed", cb, options...)
 }
 
-func (self Instance) OnInputVector2Changed(cb func(name string, vector Vector2.XY)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("input_vector2_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnInputVector2Changed(cb func(name string, vector Vector2.XY), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "input_vector2_changed", cb, options...)
 }
 
-func (self Instance) OnProfileChanged(cb func(role string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("profile_changed"), gd.NewCallable(cb), 0)
+func (self Instance) OnProfileChanged(cb func(role string), options ...Signal.Option) Signal.Subscription {
+	return gd.SubscribeSignal(self[0].AsObject()[0], "profile_changed", cb, options...)
 }
 
 func (self class) AsXRPositionalTracker() Advanced    { return *((*Advanced)(unsafe.Pointer(&self))) }
@@ -27,6 +27,7 @@ import "graphics.gd/variant/RID"
 import "graphics.gd/variant/RefCounted"
 import "graphics.gd/variant/String"
 import "graphics.gd/variant/Transform3D"
+import "graphics.gd/variant/Signal"
 
 var _ Object.ID
 
@@ -512,28 +513,34 @@ func (self class) SetPrimaryInterface(intf [1]gdclass.XRInterface) { //gd:XRServ
 	gd.Global.Object.MethodBindPointerCall(gd.Global.Methods.XRServer.Bind_set_primary_interface, self.AsObject(), frame.Array(0), r_ret.Addr())
 	frame.Free()
 }
-func OnReferenceFrameChanged(cb func()) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("reference_frame_changed"), gd.NewCallable(cb), 0)
+func OnReferenceFrameChanged(cb func(), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "reference_frame_changed", cb, options...)
 }
 
-func OnInterfaceAdded(cb func(interface_name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("interface_added"), gd.NewCallable(cb), 0)
+func OnInterfaceAdded(cb func(interface_name string), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "interface_added", cb, options...)
 }
 
-func OnInterfaceRemoved(cb func(interface_name string)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("interface_removed"), gd.NewCallable(cb), 0)
+func OnInterfaceRemoved(cb func(interface_name string), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "interface_removed", cb, options...)
 }
 
-func OnTrackerAdded(cb func(tracker_name string, atype int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tracker_added"), gd.NewCallable(cb), 0)
+func OnTrackerAdded(cb func(tracker_name string, atype int), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tracker_added", cb, options...)
 }
 
-func OnTrackerUpdated(cb func(tracker_name string, atype int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tracker_updated"), gd.NewCallable(cb), 0)
+func OnTrackerUpdated(cb func(tracker_name string, atype int), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tracker_updated", cb, options...)
 }
 
-func OnTrackerRemoved(cb func(tracker_name string, atype int)) {
-	self[0].AsObject()[0].Connect(gd.NewStringName("tracker_removed"), gd.NewCallable(cb), 0)
+func OnTrackerRemoved(cb func(tracker_name string, atype int), options ...Signal.Option) Signal.Subscription {
+	once.Do(singleton)
+	return gd.SubscribeSignal(self[0].AsObject()[0], "tracker_removed", cb, options...)
 }
 
 func (self class) Virtual(name string) reflect.Value {
@@ -138,8 +138,14 @@ const (
 	PropertyHintHideQuaternionEdit PropertyHint = 35
 	/*Hints that a string property is a password, and every character is replaced with the secret character.*/
 	PropertyHintPassword PropertyHint = 36
+	/*Hints that a property is a [Dictionary] with the stored key and value types specified in the hint string, separated by a semicolon ([code]"int;String"[/code]).*/
+	PropertyHintDictionaryType PropertyHint = 38
+	/*Hints that a [Callable] property should be displayed as a clickable button. The hint string is the text of the button, optionally followed by a comma and the name of an editor icon ([code]"Reset,Reload"[/code]).*/
+	PropertyHintToolButton PropertyHint = 39
+	/*Hints that a property will be changed on its own after setting, such as [member AudioStreamPlayer.playing] or [member GPUParticles3D.emitting].*/
+	PropertyHintOneshot PropertyHint = 40
 	/*Represents the size of the [enum PropertyHint] enum.*/
-	PropertyHintMax PropertyHint = 38
+	PropertyHintMax PropertyHint = 41
 )
 
 type PropertyUsageFlags int
@@ -0,0 +1,299 @@
+package classdb
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"path"
+	"reflect"
+	"runtime"
+	"strings"
+
+	gd "graphics.gd/internal"
+	"graphics.gd/internal/gdclass"
+	"graphics.gd/variant"
+	"graphics.gd/variant/Enum"
+	"graphics.gd/variant/String"
+)
+
+// Report describes how a class would be registered by [Register], see [Check].
+type Report struct {
+	Class   string // name of the class within the engine.
+	Extends string // name of the engine class that the class extends.
+
+	Errors []error // problems that would cause [Register] to panic.
+
+	Properties []Export
+	Methods    []Export
+	Signals    []Export
+}
+
+// Export describes a field, method or signal of a class that is exported to the engine.
+type Export struct {
+	Go      string // Go name of the field or method, ie. "Stats.Health"
+	Name    string // name within the engine, ie. "stats/health"
+	Type    string // engine type of the property, or the signature of the method or signal, ie. "(Int, String) -> Bool"
+	Skipped string // reason why this was not exported, or only partially exported (empty if fully exported).
+}
+
+// Err returns an error describing each problem in the report and each field, method or signal
+// that was skipped, or nil if the class would be fully registered.
+func (report Report) Err() error {
+	var errs = append([]error(nil), report.Errors...)
+	for _, exports := range [][]Export{report.Properties, report.Methods, report.Signals} {
+		for _, export := range exports {
+			if export.Skipped != "" {
+				errs = append(errs, fmt.Errorf("%v.%v: %s", report.Class, export.Go, export.Skipped))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// String returns a vet-style listing of the report, one line per export.
+func (report Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%v extends %v\n", report.Class, report.Extends)
+	for _, err := range report.Errors {
+		fmt.Fprintf(&b, "\terror: %v\n", err)
+	}
+	for _, kind := range []struct {
+		name    string
+		exports []Export
+	}{
+		{"property", report.Properties},
+		{"method", report.Methods},
+		{"signal", report.Signals},
+	} {
+		for _, export := range kind.exports {
+			fmt.Fprintf(&b, "\t%s %s %s (%s)", kind.name, export.Name, export.Type, export.Go)
+			if export.Skipped != "" {
+				fmt.Fprintf(&b, ": %s", export.Skipped)
+			}
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// Check reports how T would be registered by [Register] with the given exports, without
+// registering it, such that tests can fail when a field silently disappears from the
+// inspector or a method argument cannot be converted. Check does not require the engine.
+//
+//	func TestPlayer(t *testing.T) {
+//		if err := classdb.Check[Player]().Err(); err != nil {
+//			t.Fatal(err)
+//		}
+//	}
+func Check[T Class](exports ...any) Report {
+	var report Report
+	var classType = reflect.TypeFor[T]()
+	report.Class = nameOf(classType)
+	report.Extends = nameOf(gdclass.SuperType(([1]T{})[0]))
+	if err := checkClassType(classType); err != nil {
+		report.Errors = append(report.Errors, err)
+		return report
+	}
+	var super = reflect.New(gdclass.SuperType(([1]T{})[0])).Elem().Interface()
+	_, tool := any(([1]T{})[0]).(Tool)
+	tool = tool || isToolClass(super)
+	var renames = make(map[uintptr]string)
+	var buttons int
+	for _, export := range exports {
+		switch export := export.(type) {
+		case map[string]string, map[string]int:
+		case Virtuals:
+			for _, virtual := range export {
+				report.catch(func() { checkVirtual(classType, virtual) })
+			}
+		case map[string]ToolButton:
+			buttons += len(export)
+			if tool {
+				for name := range export {
+					report.catch(func() { buttonMethod(classType, name) })
+				}
+			}
+		case map[string]RPC:
+			report.catch(func() { registerRPCs(classType, gdclass.SuperType(([1]T{})[0]), export, renames) })
+		case map[string]any:
+			for name, value := range export {
+				if value == nil {
+					report.Errors = append(report.Errors, fmt.Errorf("invalid map elem %v (expected non-nil value)", name))
+					continue
+				}
+				rvalue := reflect.ValueOf(value)
+				switch rvalue.Kind() {
+				case reflect.Func:
+					if pc := rvalue.Pointer(); strings.Count(path.Base(runtime.FuncForPC(pc).Name()), ".") > 1 {
+						renames[pc] = name
+					}
+				default:
+					if err := checkStaticValue(name, rvalue); err != nil {
+						report.Errors = append(report.Errors, err)
+					}
+				}
+			}
+		default:
+			rvalue := reflect.ValueOf(export)
+			if rvalue.Kind() != reflect.Func {
+				report.Errors = append(report.Errors, fmt.Errorf("invalid argument type %T (expected function or map)", export))
+				continue
+			}
+			pc := rvalue.Pointer()
+			fname := runtime.FuncForPC(pc).Name()
+			if strings.Count(path.Base(fname), ".") > 1 {
+				renames[pc] = fname[String.FindLast(fname, ".")+1:]
+			}
+		}
+	}
+	if buttons > 0 && !tool {
+		report.Errors = append(report.Errors, fmt.Errorf("%v must embed classdb.Tool in order to add inspector buttons", classType.Name()))
+	}
+	report.catch(func() { defaultsOf(report.Class, classType) })
+	if registersMembers(super) {
+		report.checkProperties(classType, tool)
+		report.checkSignals(classType)
+		report.catch(func() { report.checkMethods(classType, renames) })
+	}
+	report.checkOverrides(classType, ([1]T{})[0].Virtual)
+	return report
+}
+
+// checkOverrides reports the methods of the class that override a virtual method of the
+// super class with the wrong signature, as the engine looks them up with GetVirtual.
+func (report *Report) checkOverrides(rtype reflect.Type, virtuals func(string) reflect.Value) {
+	ptype := reflect.PointerTo(rtype)
+	for i := range ptype.NumMethod() {
+		name := "_" + String.ToSnakeCase(ptype.Method(i).Name)
+		if virtual := virtuals(name); virtual.IsValid() {
+			report.catch(func() { virtualMethodOf(rtype, name, virtual) })
+		}
+	}
+}
+
+// catch appends any registration panic raised by fn to the errors of the report.
+func (report *Report) catch(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			report.Errors = append(report.Errors, errors.New(strings.TrimPrefix(fmt.Sprint(r), "gdextension.RegisterClass: ")))
+		}
+	}()
+	fn()
+}
+
+// enumOf returns the values of the given enum type, or nil if it is not an enum.
+func enumOf(rtype reflect.Type) iter.Seq2[string, int] {
+	if !rtype.Implements(reflect.TypeFor[Enum.Any]()) {
+		return nil
+	}
+	return reflect.New(rtype).Interface().(Enum.Any).Enum
+}
+
+// String returns the engine type of the property, as shown in a [Report].
+func (info property) String() string {
+	if info.Type == gd.TypeObject && info.ClassName != "" {
+		return info.ClassName
+	}
+	return variant.Type(info.Type).String()
+}
+
+// checkProperties reports the fields of the class that [Register] registers as properties, in
+// the same order.
+func (report *Report) checkProperties(rtype reflect.Type, tool bool) {
+	classFields{
+		class: report.Class,
+		signal: func(field reflect.StructField, goName, name string) {
+			if goName != field.Name {
+				report.Signals = append(report.Signals, Export{
+					Go:      goName,
+					Name:    name,
+					Type:    field.Type.String(),
+					Skipped: "not registered, signals are only registered for the top-level fields of a class",
+				})
+			}
+		},
+		property: func(field reflect.StructField, goName, name string) {
+			export := Export{Go: goName, Name: name}
+			info, err := describeProperty(field, enumOf(field.Type))
+			switch {
+			case errors.Is(err, errUnsupported):
+				export.Type = field.Type.String()
+				export.Skipped = "not exported, " + err.Error()
+			case err != nil:
+				report.Errors = append(report.Errors, fmt.Errorf("%v.%v has an invalid tag: %w", report.Class, field.Name, err))
+				return
+			default:
+				export.Type = info.String()
+			}
+			if _, ok := field.Tag.Lookup("button"); ok && !tool {
+				report.Errors = append(report.Errors, fmt.Errorf("%v must embed classdb.Tool in order to add the %v button", report.Class, export.Name))
+			}
+			report.Properties = append(report.Properties, export)
+		},
+		fail: func(err error) {
+			report.Errors = append(report.Errors, err)
+		},
+	}.walk(rtype, "", "", 0)
+}
+
+// checkMethods reports the methods of the class that [Register] registers.
+func (report *Report) checkMethods(rtype reflect.Type, renames map[uintptr]string) {
+	for name, method := range classMethods(rtype, renames) {
+		export := Export{Go: method.Name, Name: name}
+		var args, skipped []string
+		for i := 1; i < method.Type.NumIn(); i++ {
+			info, err := describeProperty(reflect.StructField{Name: "arg" + fmt.Sprint(i), Type: method.Type.In(i)}, enumOf(method.Type.In(i)))
+			if err != nil {
+				args = append(args, method.Type.In(i).String())
+				skipped = append(skipped, fmt.Sprintf("argument %d dropped, %v", i, err))
+				continue
+			}
+			args = append(args, info.String())
+		}
+		export.Type = "(" + strings.Join(args, ", ") + ")"
+		if method.Type.NumOut() > 0 {
+			info, err := describeProperty(reflect.StructField{Name: "result", Type: method.Type.Out(0)}, enumOf(method.Type.Out(0)))
+			if err != nil {
+				export.Type += " -> " + method.Type.Out(0).String()
+				skipped = append(skipped, fmt.Sprintf("result dropped, %v", err))
+			} else {
+				export.Type += " -> " + info.String()
+			}
+		}
+		export.Skipped = strings.Join(skipped, "; ")
+		report.Methods = append(report.Methods, export)
+	}
+}
+
+// checkSignals reports the signal fields of the class that [Register] registers.
+func (report *Report) checkSignals(rtype reflect.Type) {
+	for signal, err := range classSignals(rtype) {
+		if err != nil {
+			report.Errors = append(report.Errors, err)
+			continue
+		}
+		export := Export{Go: signal.field.Name, Name: signal.name, Skipped: signal.skipped}
+		if signal.skipped != "" {
+			export.Type = signal.field.Type.String()
+			report.Signals = append(report.Signals, export)
+			continue
+		}
+		var args, skipped []string
+		for i, arg := range signal.args {
+			vtype, ok := gd.VariantTypeOf(arg)
+			if !ok {
+				args = append(args, arg.String())
+				skipped = append(skipped, fmt.Sprintf("argument %d dropped, %v %v", i+1, errUnsupported, arg))
+				continue
+			}
+			if class := nameOf(arg); vtype == gd.TypeObject && class != "" {
+				args = append(args, class)
+			} else {
+				args = append(args, variant.Type(vtype).String())
+			}
+		}
+		export.Type = "(" + strings.Join(args, ", ") + ")"
+		export.Skipped = strings.Join(skipped, "; ")
+		report.Signals = append(report.Signals, export)
+	}
+}
@@ -0,0 +1,124 @@
+package classdb_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"graphics.gd/classdb"
+	"graphics.gd/classdb/Node"
+	"graphics.gd/variant/Array"
+)
+
+type CheckContainers struct {
+	classdb.Extension[CheckContainers, Node.Instance]
+
+	Scores  []int
+	Weights [3]float64
+	Names   map[string]int
+	Tags    Array.Contains[string]
+	Scored  chan<- []int
+}
+
+func (*CheckContainers) Sum(values []int) []float64 { return nil }
+
+// TestCheckOnHost runs without the engine, as Check is intended to be used from a CI.
+func TestCheckOnHost(t *testing.T) {
+	report := classdb.Check[CheckContainers]()
+	if err := report.Err(); err != nil {
+		t.Fatal(err)
+	}
+	for _, expect := range []classdb.Export{
+		{Go: "Scores", Name: "scores", Type: "Array"},
+		{Go: "Weights", Name: "weights", Type: "Array"},
+		{Go: "Names", Name: "names", Type: "Dictionary"},
+		{Go: "Tags", Name: "tags", Type: "Array"},
+		{Go: "Sum", Name: "sum", Type: "(Array) -> PackedFloat64Array"},
+		{Go: "Scored", Name: "scored", Type: "(Array)"},
+	} {
+		var found bool
+		for _, export := range slices.Concat(report.Properties, report.Methods, report.Signals) {
+			if export == expect {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected %+v in the report:\n%v", expect, report)
+		}
+	}
+}
+
+type CheckInvalid struct {
+	classdb.Extension[CheckInvalid, Node.Instance]
+	classdb.Tool
+
+	Health int `default:"abc"`
+}
+
+func (*CheckInvalid) Process(delta string) {}
+func (*CheckInvalid) Reset(hard bool)      {}
+
+func TestCheckRegisterPanics(t *testing.T) {
+	report := classdb.Check[CheckInvalid](
+		map[string]classdb.ToolButton{"Reset": {}, "Missing": {}},
+		classdb.Virtuals{func() {}},
+	)
+	var errs []string
+	for _, err := range report.Errors {
+		errs = append(errs, err.Error())
+	}
+	for _, expect := range []string{
+		"CheckInvalid.Health has an invalid default tag",
+		"CheckInvalid.Reset must not accept any arguments",
+		"CheckInvalid has no Missing method",
+		"invalid virtual func()",
+		"Method CheckInvalid.Process does not match",
+	} {
+		if !slices.ContainsFunc(errs, func(err string) bool { return strings.Contains(err, expect) }) {
+			t.Errorf("expected an error containing %q, got %q", expect, errs)
+		}
+	}
+}
+
+type CheckDefaults struct {
+	classdb.Extension[CheckDefaults, Node.Instance]
+
+	Health int     `default:"10"`
+	Speed  float64 `default:"2.5"`
+	Name   string  `default:"bob"`
+}
+
+func TestCheckDefaults(t *testing.T) {
+	if err := classdb.Check[CheckDefaults]().Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type CheckGroups struct {
+	classdb.Extension[CheckGroups, Node.Instance]
+
+	Movement struct {
+		Speed float64 `group:"Walking"`
+		Jump  struct {
+			Height  float64
+			Gravity struct {
+				Scale float64
+			}
+			Boost float64 `subgroup:"Boost"`
+		}
+	}
+}
+
+func TestCheckGroups(t *testing.T) {
+	report := classdb.Check[CheckGroups]()
+	if len(report.Errors) != 1 || !strings.Contains(report.Errors[0].Error(), "CheckGroups.Movement.Jump.Boost has a subgroup tag") {
+		t.Fatalf("expected an error for the subgroup below a subgroup, got %v", report.Errors)
+	}
+	var names []string
+	for _, export := range report.Properties {
+		names = append(names, export.Name)
+	}
+	if expect := []string{"movement/speed", "movement/jump/height", "movement/jump/gravity/scale", "movement/jump/boost"}; !slices.Equal(names, expect) {
+		t.Fatalf("expected properties %v, got %v", expect, names)
+	}
+}
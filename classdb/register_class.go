@@ -2,6 +2,7 @@ package classdb
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"path"
 	"reflect"
@@ -12,8 +13,10 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	EditorInspectorPluginClass "graphics.gd/classdb/EditorInspectorPlugin"
 	EditorInterfaceClass "graphics.gd/classdb/EditorInterface"
 	EditorPluginClass "graphics.gd/classdb/EditorPlugin"
+	EditorPropertyClass "graphics.gd/classdb/EditorProperty"
 	EngineClass "graphics.gd/classdb/Engine"
 	NodeClass "graphics.gd/classdb/Node"
 	ScriptClass "graphics.gd/classdb/Script"
@@ -33,6 +36,18 @@ import (
 // Tool can be embedded inside a struct to make it run in the editor.
 type Tool interface{ tool() }
 
+// Abstract can be embedded inside a struct to prevent it from being instantiated
+// by The Engine, such that it can only be used as a base class.
+type Abstract interface{ abstract() }
+
+// Runtime can be embedded inside a struct so that it is only instantiated at runtime,
+// inside the editor, a placeholder instance is used instead.
+type Runtime interface{ runtime() }
+
+// Internal can be embedded inside a struct to hide it from the editor and from scripts,
+// such that it is only available to Go.
+type Internal interface{ internal() }
+
 // Deprecated: use a classdb package Extension instead, ie. Node.Extension[MyClass]
 type Extension[T Class, S gd.IsClass] = gdclass.Extension[T, S]
 
@@ -59,7 +74,15 @@ parent class to extend.
 	}
 
 The tag can be adjusted in order to change the name of the class
-within The Engine.
+within The Engine. An 'icon' tag on the same field sets the path to
+the SVG icon shown for the class in the editor, ie.
+
+	Class[MyClass, Node2D] `gd:"MyClass" icon:"res://icons/my_class.svg"`
+
+Embed [Tool], [Abstract], [Runtime] or [Internal] inside the struct
+to run it in the editor, to prevent it from being instantiated, to
+only instantiate it at runtime or to hide it from the editor and
+scripts.
 
 Use this in a main or init function to register your Go structs
 and they will become available within The Engine for use in the
@@ -70,65 +93,138 @@ take caution when embedding types, as their fields and methods
 will be promoted. They will be exported as snake_case by default,
 for fields, the exported name can be adjusted with the 'gd' tag.
 
+The way that a field is presented in the editor's inspector can be
+adjusted with the following tags (equivalent to GDScript's @export_*
+annotations):
+
+	Speed float64    `range:"0,100,0.1,or_greater" suffix:"m/s"`
+	Icon  string     `hint:"file:*.png,*.svg"`
+	Notes string     `hint:"multiline"`
+	Tint  Color.RGBA `hint:"color_no_alpha"`
+	Mask  int        `hint:"layers_2d_physics"`
+	Flags int        `hint:"flags:Fire,Water,Earth"`
+	Cache []byte     `usage:"storage"`
+
+The 'hint' tag accepts the snake_case name of any [PropertyHint], optionally
+followed by a colon and the hint string. The 'usage' tag accepts a comma
+separated list of snake_case [PropertyUsageFlags] names. An unknown or
+malformed tag will cause Register to panic.
+
+Slice, array and map fields (along with Array.Contains and Dictionary.Map)
+are exported as typed arrays and dictionaries, such that the inspector
+only accepts elements (or keys and values) of the corresponding types.
+
+The 'default' tag sets the initial value of the field for new instances
+(unless a constructor is provided) and the value that the inspector will
+revert the field to. It is written in the same syntax as the engine's
+text constructors, ie.
+
+	Offset Vector3.XYZ        `default:"Vector3(1, 2, 3)"`
+	Tint   Color.RGBA         `default:"Color(\"#ff0\")"`
+	Target Path.ToNode        `default:"^\"../Player\""`
+	Items  []int              `default:"[1, 2]"`
+	Mode   MyEnum             `default:"FAST"`
+	Icon   Texture2D.Instance `default:"Resource(\"res://icon.svg\")"`
+
+Numbers are accepted by both integer and float fields, as long as they fit,
+so 1.0 is a valid default for an int field. Resources are loaded when the
+first instance is constructed and shared by the instances that follow.
+
+Fields of a nested (or embedded) plain Go struct are grouped together
+in the inspector, where a named struct field is exposed to scripts via
+a 'group/field' path. One level of nesting is shown as a group and the
+next as a subgroup. The displayed group name can be adjusted with the
+'group' tag. Alternatively, a 'group', 'subgroup' or 'category' tag on
+a regular field starts a new group, subgroup or category in the inspector
+from that field onwards. Within a nested struct, these tags are relative
+to the struct's group, such that a 'group' tag there starts a subgroup.
+The inspector has no levels below a subgroup, so fields of more deeply
+nested structs are shown within the enclosing subgroup and a 'group' or
+'subgroup' tag that would start a level below a subgroup causes Register
+to panic.
+
+	Movement struct {
+		Speed float64 `range:"0,10"`
+		Jump  float64
+	} `group:"Movement Settings"`               // movement/speed, movement/jump
+	Health int  `category:"Stats" group:"Vitals"` // health
+
+A func() field of a [Tool] class can be shown as a button in the inspector
+with the 'button' tag, which is the text of the button, optionally followed
+by a comma and the name of an editor icon. Fields which type implements
+[PropertyEditor] are edited with a custom editor in the inspector.
+
+	Reset func() `button:"Reset Position,Reload"`
+
 This function accepts a variable number of additional arguments,
 they may either be func, map[string]any (where each any is a func),
-map[string]string or map[string]int, these arguments can be used to
-register static methods, rename existing methods, add symbol documentation
-or to define constants respectively. As a special case, if a function is
-passed which name begins with 'New' and accepts no arguments, returning T,
-then it will be registered as the constructor for the class when it is
-instantiated from within The Engine.
+map[string]string, map[string]int, map[string]RPC, map[string]ToolButton
+or [Virtuals], these arguments can be used to register static methods,
+rename existing methods, add symbol documentation, to define constants,
+to configure methods as remote procedure calls, to add inspector buttons
+for methods or to declare virtual methods respectively. As a special case,
+if a function is passed which name begins with 'New' and accepts no arguments,
+returning T, then it will be registered as the constructor for the class when
+it is instantiated from within The Engine.
+
+The values of a map[string]any may also be integer constants, which are registered as
+class constants. The engine only supports integer constants on extension classes and
+extension classes cannot have static properties, so Register panics for any other value,
+register a static method that returns (or sets) the value instead.
+
+	classdb.Register[Player](map[string]any{
+		"MAX_HEALTH": 100,
+		"spawn":      func() Vector2.XY { return Vector2.New(10, 20) },
+	})
+
+Fields with an Enum.Int type register their enum with the class and fields with an
+Enum.Bits type register their enum as a bitfield, so that the flags can be combined
+from GDScript.
 
 If the Struct extends [EditorPluginClass] then it will be added
 to the editor as a plugin.
+
+Fields, method arguments and signal arguments with types that cannot be
+represented by the engine are skipped, use [Check] to find out which.
 */
 func Register[T Class](exports ...any) {
 	var superType = gdclass.SuperType(([1]T{})[0])
 	var super = reflect.New(superType).Elem().Interface()
 	register := func() {
 		var classType = reflect.TypeFor[T]()
-		var base = classType
-		for base.Field(0).Anonymous {
-			if base.Field(0).Name == "Class" {
-				break
-			}
-			base = base.Field(0).Type
-		}
-		if !base.Implements(reflect.TypeFor[Class]()) {
-			panic("gdextension.RegisterClass: Class type must embed a gd.Extension field as the first field")
-		}
-
-		if classType.Kind() != reflect.Struct || classType.Name() == "" {
-			panic("gdextension.RegisterClass: Class type must be a named struct")
+		if err := checkClassType(classType); err != nil {
+			panic("gdextension.RegisterClass: " + err.Error())
 		}
 		var rename = nameOf(classType) // support 'gd' tag for renaming the class within Godot.
-		var tool = false
-		switch super.(type) {
-		case interface{ AsScript() ScriptClass.Instance },
-			interface {
-				AsEditorPlugin() EditorPluginClass.Instance
-			},
-			interface {
-				AsScriptLanguage() ScriptLanguageClass.Instance
-			}:
-			tool = true
-		}
+		var tool = isToolClass(super)
 		switch any(([1]T{})[0]).(type) {
 		case Tool:
 			tool = true
 		}
+		_, abstract := any(([1]T{})[0]).(Abstract)
+		_, runtimeOnly := any(([1]T{})[0]).(Runtime)
+		_, internal := any(([1]T{})[0]).(Internal)
+		icon := classType.Field(0).Tag.Get("icon")
 		var reference T
 		var className = pointers.Pin(gd.NewStringName(rename))
 		var superName = pointers.Pin(gd.NewStringName(nameOf(superType)))
 
+		var defaults = defaultsOf(rename, classType)
 		var impl = &classImplementation{
 			Name:           className,
 			Super:          superName,
 			Type:           classType,
 			Tool:           tool,
+			Abstract:       abstract,
+			Runtime:        runtimeOnly,
+			Exposed:        !internal,
+			Icon:           icon,
 			VirtualMethods: reference.Virtual,
+			Defaults:       defaults,
 			Constructor: func() reflect.Value {
-				return reflect.New(classType)
+				value := reflect.New(classType)
+				setDefaults(value.Elem(), defaults)
+				return value
 			},
 		}
 		gdclass.Registered.Store(classType, impl)
@@ -145,8 +241,21 @@ func Register[T Class](exports ...any) {
 			documentation = make(map[string]string)
 		)
 		var method_renames = make(map[uintptr]string)
+		var rpcs = make(map[string]RPC)
+		var buttons = make(map[string]ToolButton)
+		var virtuals Virtuals
 		for _, export := range exports {
 			switch export := export.(type) {
+			case Virtuals:
+				virtuals = append(virtuals, export...)
+			case map[string]ToolButton:
+				for name, button := range export {
+					buttons[name] = button
+				}
+			case map[string]RPC:
+				for name, rpc := range export {
+					rpcs[name] = rpc
+				}
 			case map[string]string:
 				for name, value := range export {
 					documentation[name] = value
@@ -158,8 +267,12 @@ func Register[T Class](exports ...any) {
 				}
 			case map[string]any:
 				for name, fn := range export {
+					if fn == nil {
+						panic(fmt.Sprintf("gdextension.RegisterClass: invalid map elem %v (expected non-nil value)", name))
+					}
 					if reflect.TypeOf(fn).Kind() != reflect.Func {
-						panic(fmt.Sprintf("gdextension.RegisterClass: invalid map elem type %T (expected function)", fn))
+						registerStaticValue(className, name, reflect.ValueOf(fn))
+						continue
 					}
 					rvalue := reflect.ValueOf(fn)
 					pc := rvalue.Pointer()
@@ -193,14 +306,22 @@ func Register[T Class](exports ...any) {
 				}
 			}
 		}
-		switch super.(type) {
-		case interface {
-			AsShaderMaterial() ShaderMaterialClass.Instance
-		}:
-		default:
+		var rpcMethods map[uintptr]string
+		impl.RPCs, rpcMethods = registerRPCs(classType, superType, rpcs, method_renames)
+		for pc, name := range rpcMethods {
+			rpcNames.Store(pc, name)
+		}
+		gd.RegisterCleanup(func() {
+			for pc := range rpcMethods {
+				rpcNames.Delete(pc)
+			}
+		})
+		if registersMembers(super) {
 			registerClassInformation(className, rename, nameOf(superType), classType, documentation, method_renames)
+			impl.Buttons = registerButtons(className, classType, tool, buttons, method_renames)
 			registerSignals(className, classType)
 			registerMethods(className, classType, method_renames)
+			registerVirtualMethods(className, classType, virtuals)
 		}
 		if registrator, ok := any(reference).(interface{ OnRegister() }); ok {
 			registrator.OnRegister()
@@ -212,22 +333,74 @@ func Register[T Class](exports ...any) {
 			}
 		}
 	}
+	registerPropertyEditors(reflect.TypeFor[T]())
+	switch {
+	case isToolClass(super):
+		gd.EditorStartupFunctions = append(gd.EditorStartupFunctions, register)
+	default:
+		if gd.Linked {
+			register()
+		} else {
+			gd.StartupFunctions = append(gd.StartupFunctions, register)
+		}
+	}
+}
+
+// registersMembers reports whether the properties, signals and methods of classes that extend
+// the given engine class are registered.
+func registersMembers(super any) bool {
+	switch super.(type) {
+	case interface {
+		AsShaderMaterial() ShaderMaterialClass.Instance
+	}:
+		return false
+	default:
+		return true
+	}
+}
+
+// isToolClass reports whether classes that extend the given engine class always run
+// in the editor, so that they need to be registered at the editor initialization level.
+func isToolClass(super any) bool {
 	switch super.(type) {
 	case interface{ AsScript() ScriptClass.Instance },
 		interface {
 			AsEditorPlugin() EditorPluginClass.Instance
 		},
+		interface {
+			AsEditorInspectorPlugin() EditorInspectorPluginClass.Instance
+		},
+		interface {
+			AsEditorProperty() EditorPropertyClass.Instance
+		},
 		interface {
 			AsScriptLanguage() ScriptLanguageClass.Instance
 		}:
-		gd.EditorStartupFunctions = append(gd.EditorStartupFunctions, register)
-	default:
-		if gd.Linked {
-			register()
-		} else {
-			gd.StartupFunctions = append(gd.StartupFunctions, register)
+		return true
+	}
+	return false
+}
+
+// checkClassType returns an error if the given type cannot be registered as a class.
+func checkClassType(classType reflect.Type) error {
+	if classType.Kind() != reflect.Struct || classType.Name() == "" {
+		return errors.New("Class type must be a named struct")
+	}
+	var base = classType
+	for base.Kind() == reflect.Struct && base.NumField() > 0 && base.Field(0).Anonymous {
+		if base.Field(0).Name == "Class" {
+			break
 		}
+		base = base.Field(0).Type
+	}
+	if !base.Implements(reflect.TypeFor[Class]()) {
+		return errors.New("Class type must embed a gd.Extension field as the first field")
+	}
+	icon := classType.Field(0).Tag.Get("icon")
+	if icon != "" && !strings.HasSuffix(strings.ToLower(icon), ".svg") {
+		return fmt.Errorf("%v has an invalid icon %q (expected an .svg path)", classType.Name(), icon)
 	}
+	return nil
 }
 
 func convertName(fnName string) string {
@@ -269,18 +442,32 @@ func registerClassInformation(className gd.StringName, classNameString string, i
 		class.BriefDescription = brief
 		class.Description = whole
 	}
-	for _, field := range reflect.VisibleFields(rtype) {
-		if !field.IsExported() || field.Anonymous || field.Name == "Object" {
-			continue
-		}
-		if _, ok := field.Type.MethodByName("AsNode"); ok || field.Type.Kind() == reflect.Chan {
-			continue
-		}
-		name := String.ToSnakeCase(field.Name)
-		if field.Tag.Get("gd") != "" {
-			name = field.Tag.Get("gd")
-		}
-		if reflect.PointerTo(field.Type).Implements(reflect.TypeFor[Signal.Pointer]()) {
+	// group registers a group (depth 0) or subgroup (depth 1) for the properties that
+	// follow it, the inspector only supports these two levels of grouping.
+	group := func(depth int, name, prefix string) {
+		switch depth {
+		case 0:
+			gd.Global.ClassDB.RegisterClassPropertyGroup(gd.Global.ExtensionToken, className, gd.NewString(name), gd.NewString(prefix))
+		case 1:
+			gd.Global.ClassDB.RegisterClassPropertySubGroup(gd.Global.ExtensionToken, className, gd.NewString(name), gd.NewString(prefix))
+		}
+	}
+	classFields{
+		class: classNameString,
+		group: group,
+		category: func(name string) {
+			gd.Global.ClassDB.RegisterClassProperty(gd.Global.ExtensionToken, className, gd.PropertyInfo{
+				Type:       gd.TypeNil,
+				Name:       gd.NewStringName(name),
+				ClassName:  gd.NewStringName(""),
+				HintString: gd.NewString(""),
+				Usage:      int64(PropertyUsageCategory),
+			}, gd.NewStringName(""), gd.NewStringName(""))
+		},
+		signal: func(field reflect.StructField, goName, name string) {
+			if goName != field.Name || !reflect.PointerTo(field.Type).Implements(reflect.TypeFor[Signal.Pointer]()) {
+				return // signals are only registered for the top-level fields.
+			}
 			var signal xmlSignal
 			name, _, _ = strings.Cut(name, "(")
 			signal.Name = name
@@ -289,10 +476,13 @@ func registerClassInformation(className gd.StringName, classNameString string, i
 				signal.Description = extractDoc(docs)
 			}
 			class.Signals = append(class.Signals, signal)
-			continue
-		}
-		ptype, ok := propertyOf(className, field)
-		if ok {
+		},
+		property: func(field reflect.StructField, goName, name string) {
+			ptype, ok := propertyOf(className, field)
+			if !ok {
+				return
+			}
+			ptype.Name = gd.NewStringName(name)
 			var member xmlMember
 			member.Name = name
 			member.Description = extractDocTag(field.Tag)
@@ -305,8 +495,11 @@ func registerClassInformation(className gd.StringName, classNameString string, i
 			member.Type = ptype.Type.String()
 			class.Members = append(class.Members, member)
 			gd.Global.ClassDB.RegisterClassProperty(gd.Global.ExtensionToken, className, ptype, gd.NewStringName(""), gd.NewStringName(""))
-		}
-	}
+		},
+		fail: func(err error) {
+			panic("gdextension.RegisterClass: " + err.Error())
+		},
+	}.walk(rtype, "", "", 0)
 	rtype = reflect.PointerTo(rtype)
 	for i := 0; i < rtype.NumMethod(); i++ {
 		name := String.ToSnakeCase(rtype.Method(i).Name)
@@ -333,12 +526,20 @@ type classImplementation struct {
 	Name  gd.StringName
 	Super gd.StringName
 
-	Tool bool
+	Tool     bool
+	Abstract bool
+	Runtime  bool
+	Exposed  bool
+	Icon     string // path to an SVG icon, if any.
 
 	Type reflect.Type
 
 	VirtualMethods func(string) reflect.Value
 	Constructor    func() reflect.Value
+	Defaults       []defaultTag // see [defaultsOf].
+
+	RPCs    map[string]RPC    // keyed by method name.
+	Buttons map[string]string // property name to method name.
 }
 
 var _ gd.ClassInterface = classImplementation{}
@@ -348,11 +549,19 @@ func (class classImplementation) IsVirtual() bool {
 }
 
 func (class classImplementation) IsAbstract() bool {
-	return class.Type.Kind() == reflect.Interface
+	return class.Abstract || class.Type.Kind() == reflect.Interface
 }
 
 func (class classImplementation) IsExposed() bool {
-	return true // TODO return false if the Go type is not exported.
+	return class.Exposed
+}
+
+func (class classImplementation) IsRuntime() bool {
+	return class.Runtime
+}
+
+func (class classImplementation) IconPath() string {
+	return class.Icon
 }
 
 func (class classImplementation) CreateInstance() [1]gd.Object {
@@ -365,10 +574,33 @@ func (class classImplementation) CreateInstanceFrom(value reflect.Value) [1]gd.O
 	instance := class.reloadInstance(value, super)
 	gd.Global.Object.SetInstance(super, class.Name, instance)
 	gd.Global.Object.SetInstanceBinding(super, gd.Global.ExtensionToken, nil, nil)
+	class.configureRPCs(super)
 	instance.OnCreate(value)
 	return super
 }
 
+// RecreateInstance binds a new instance of the class to an existing engine object, such that the
+// object's Go implementation can be replaced, ie. after a hot-reload.
+func (class classImplementation) RecreateInstance(super [1]gd.Object) gd.ObjectInterface {
+	value := class.Constructor()
+	instance := class.reloadInstance(value, super)
+	class.configureRPCs(super)
+	instance.OnCreate(value)
+	return instance
+}
+
+// configureRPCs configures the RPCs of the class on the given object, if it is a node.
+func (class classImplementation) configureRPCs(super [1]gd.Object) {
+	if len(class.RPCs) == 0 {
+		return
+	}
+	if node, ok := As[NodeClass.Instance](Object.Instance(super)); ok {
+		for method, rpc := range class.RPCs {
+			node.RpcConfig(method, rpc.config())
+		}
+	}
+}
+
 func (class classImplementation) reloadInstance(value reflect.Value, super [1]gd.Object) gd.ObjectInterface {
 	extensionClass := value.Interface().(gdclass.Pointer)
 	gdclass.SetObject(extensionClass, super)
@@ -419,6 +651,7 @@ func (class classImplementation) reloadInstance(value reflect.Value, super [1]gd
 	}
 	return &instanceImplementation{
 		object:   pointers.Get(super[0])[0],
+		id:       gd.ObjectID(super[0].AsObject()[0].GetInstanceId()),
 		Value:    value.Addr().Interface().(gdclass.Pointer),
 		signals:  signals,
 		isEditor: !class.Tool && EngineClass.IsEditorHint(),
@@ -433,33 +666,45 @@ func (class classImplementation) GetVirtual(name gd.StringName) any {
 	if !virtual.IsValid() {
 		return nil
 	}
+	method, ok := virtualMethodOf(class.Type, name.String(), virtual)
+	if !ok {
+		return nil
+	}
+	var vtype = virtual.Type().In(0)
+	var copy = reflect.New(method.Type)
+	copy.Elem().Set(method.Func)
+	var fn = reflect.NewAt(vtype, copy.UnsafePointer()).Elem()
+	return virtual.Call([]reflect.Value{fn})[0].Interface()
+}
+
+// virtualMethodOf returns the method of the class that overrides the named virtual method of
+// its super class, panicking if the method does not match the signature of the virtual method.
+func virtualMethodOf(class reflect.Type, name string, virtual reflect.Value) (reflect.Method, bool) {
 	var vtype = virtual.Type().In(0)
-	GoName := convertName(name.String())
+	GoName := convertName(name)
 	if GoName == "Ready" {
-		return nil // special case, as we override this method for all node types, so that we can assert the scene tree.
+		return reflect.Method{}, false // special case, as we override this method for all node types, so that we can assert the scene tree.
 	}
-	method, ok := reflect.PointerTo(class.Type).MethodByName(GoName)
+	method, ok := reflect.PointerTo(class).MethodByName(GoName)
 	if !ok {
-		return nil
+		return reflect.Method{}, false
 	}
 	if method.Type.NumIn() != vtype.NumIn() {
-		panic(fmt.Sprintf("gdextension.RegisterClass: Method %s.%s does not match %s.%s\nis %s want %s", class.Type.Name(), GoName, virtual.Type().Name(), name, method.Type, vtype))
+		panic(fmt.Sprintf("gdextension.RegisterClass: Method %s.%s does not match %s.%s\nis %s want %s", class.Name(), GoName, virtual.Type().Name(), name, method.Type, vtype))
 	}
 	for i := 1; i < method.Type.NumIn(); i++ {
 		atype := method.Type.In(i)
 		btype := vtype.In(i)
 		if atype != btype && !(atype.ConvertibleTo(btype) && atype.Kind() == btype.Kind()) {
-			panic(fmt.Sprintf("gdextension.RegisterClass: Method %s.%s does not match %s.%s\nis %s want %s", class.Type.Name(), GoName, virtual.Type().Name(), name, method.Type, vtype))
+			panic(fmt.Sprintf("gdextension.RegisterClass: Method %s.%s does not match %s.%s\nis %s want %s", class.Name(), GoName, virtual.Type().Name(), name, method.Type, vtype))
 		}
 	}
-	var copy = reflect.New(method.Type)
-	copy.Elem().Set(method.Func)
-	var fn = reflect.NewAt(vtype, copy.UnsafePointer()).Elem()
-	return virtual.Call([]reflect.Value{fn})[0].Interface()
+	return method, true
 }
 
 type instanceImplementation struct {
 	object  uint64
+	id      gd.ObjectID
 	Value   gdclass.Pointer
 	signals []signalChan
 
@@ -530,7 +775,18 @@ func (instance *instanceImplementation) Free() {
 		}
 		signal.signal.Free()
 	}
-	rvalue := reflect.ValueOf(instance.Value).Elem()
+	freeFields(reflect.ValueOf(instance.Value).Elem())
+	gd.ExtensionInstances.Delete(instance.object)
+	gd.CancelObjectContext(instance.id, nil)
+	switch onfree := instance.Value.(type) {
+	case interface{ OnFree() }:
+		onfree.OnFree()
+	}
+}
+
+// freeFields frees the engine values held by the exported fields of rvalue, including
+// those in property groups, such as the resources pinned by their 'default' tags.
+func freeFields(rvalue reflect.Value) {
 	for _, field := range reflect.VisibleFields(rvalue.Type()) {
 		if !field.IsExported() || field.Name == "Extension" {
 			continue
@@ -548,11 +804,9 @@ func (instance *instanceImplementation) Free() {
 		if field.Type.Kind() == reflect.Array && field.Type.Len() == 1 && field.Type.Elem().Implements(reflect.TypeFor[interface{ Free() }]()) {
 			rvalue.FieldByIndex(field.Index).Index(0).Interface().(interface{ Free() }).Free()
 		}
-	}
-	gd.ExtensionInstances.Delete(instance.object)
-	switch onfree := instance.Value.(type) {
-	case interface{ OnFree() }:
-		onfree.OnFree()
+		if !field.Anonymous && isPropertyGroup(field.Type) {
+			freeFields(rvalue.FieldByIndex(field.Index))
+		}
 	}
 }
 
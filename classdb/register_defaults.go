@@ -0,0 +1,829 @@
+package classdb
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	ResourceClass "graphics.gd/classdb/Resource"
+	ResourceLoaderClass "graphics.gd/classdb/ResourceLoader"
+	gd "graphics.gd/internal"
+	"graphics.gd/internal/pointers"
+	"graphics.gd/variant"
+	"graphics.gd/variant/Color"
+	"graphics.gd/variant/Enum"
+)
+
+// literalKind identifies the syntax of a parsed 'default' tag value.
+type literalKind int
+
+const (
+	literalNumber     literalKind = iota // 1, -2.5, 0xff, inf, nan
+	literalString                        // "text"
+	literalStringName                    // &"name"
+	literalNodePath                      // ^"path"
+	literalIdent                         // true, null, ENUM_VALUE
+	literalCall                          // Vector3(1, 2, 3)
+	literalArray                         // [1, 2, 3]
+	literalDictionary                    // {"key": value}
+)
+
+// literal is a value written in the engine's text constructor syntax, as
+// used by var_to_str and the .tscn/.tres file formats.
+type literal struct {
+	kind literalKind
+	text string    // number, string contents, identifier or constructor name.
+	args []literal // constructor arguments, array elements or alternating dictionary keys and values.
+}
+
+// defaultTag is a 'default' struct tag, parsed ahead of time, so that it can be
+// decoded into a fresh value for each new instance of a class.
+type defaultTag struct {
+	index    []int
+	raw      string
+	value    literal
+	err      error
+	resource *defaultResource // for engine object fields.
+}
+
+// defaultResource is the resource of a 'default' tag, loaded by the first instance of the class
+// that needs it and then shared by every other instance, so that it is only loaded once.
+type defaultResource struct {
+	once  sync.Once
+	value gd.Variant
+	err   error
+}
+
+// decode returns a new reference to the resource described by lit, as a value of the given type.
+func (res *defaultResource) decode(rtype reflect.Type, lit literal) (reflect.Value, error) {
+	if lit.kind == literalIdent && lit.text == "null" {
+		return reflect.Zero(rtype), nil
+	}
+	res.once.Do(func() {
+		var path string
+		if path, res.err = resourcePathOf(rtype, lit); res.err != nil {
+			return
+		}
+		resource := ResourceLoaderClass.Load(path, "")
+		if resource == (ResourceClass.Instance{}) {
+			res.err = fmt.Errorf("cannot load %q", path)
+			return
+		}
+		res.value = pointers.Pin(gd.NewVariant(resource))
+	})
+	if res.err != nil {
+		return reflect.Value{}, res.err
+	}
+	return gd.ConvertToDesiredGoType(res.value, rtype)
+}
+
+// decode returns a new value of the given type, as described by the tag.
+func (tag defaultTag) decode(rtype reflect.Type) (reflect.Value, error) {
+	if isStringLike(rtype) {
+		if _, ok := stringOf(tag.value); tag.err != nil || !ok {
+			return decodeLiteral(rtype, literal{kind: literalString, text: tag.raw}) // unquoted strings remain valid default tags.
+		}
+	}
+	if tag.err != nil {
+		return reflect.Value{}, tag.err
+	}
+	return decodeLiteral(rtype, tag.value)
+}
+
+// decodeField returns a new value for a field of the given type, as described by the tag, any
+// resource is shared through the tag's [defaultResource].
+func (tag defaultTag) decodeField(rtype reflect.Type) (reflect.Value, error) {
+	if tag.resource != nil {
+		return tag.resource.decode(rtype, tag.value)
+	}
+	return tag.decode(rtype)
+}
+
+// defaultOf returns the tag of the field at the given index, from the result of [defaultsOf].
+func defaultOf(defaults []defaultTag, index []int) (defaultTag, bool) {
+	for _, tag := range defaults {
+		if slices.Equal(tag.index, index) {
+			return tag, true
+		}
+	}
+	return defaultTag{}, false
+}
+
+// defaultsOf returns the 'default' tags for each (possibly nested) field of rtype, Register panics if any
+// of them cannot be decoded into their field.
+func defaultsOf(class string, rtype reflect.Type) []defaultTag {
+	var defaults []defaultTag
+	var walk func(rtype reflect.Type, index []int)
+	walk = func(rtype reflect.Type, index []int) {
+		for i := range rtype.NumField() {
+			field := rtype.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			at := append(index[:len(index):len(index)], i)
+			if raw, ok := field.Tag.Lookup("default"); ok {
+				tag := parseDefault(raw)
+				tag.index = at
+				var err error
+				if isObjectType(field.Type) { // resources are loaded on demand.
+					if err = tag.err; err == nil && (tag.value.kind != literalIdent || tag.value.text != "null") {
+						_, err = resourcePathOf(field.Type, tag.value)
+					}
+					tag.resource = new(defaultResource)
+				} else {
+					_, err = tag.decode(field.Type)
+				}
+				if err != nil {
+					panic(fmt.Sprintf("gdextension.RegisterClass: %v.%v has an invalid default tag: %v", class, field.Name, err))
+				}
+				defaults = append(defaults, tag)
+				continue
+			}
+			if isPropertyGroup(field.Type) || (field.Anonymous && field.Type.Kind() == reflect.Struct) {
+				walk(field.Type, at)
+			}
+		}
+	}
+	walk(rtype, nil)
+	return defaults
+}
+
+// setDefaults initialises the fields of a newly constructed value with their 'default' tags.
+func setDefaults(value reflect.Value, defaults []defaultTag) {
+	for _, tag := range defaults {
+		field := value.FieldByIndex(tag.index)
+		decoded, err := tag.decodeField(field.Type())
+		if err != nil {
+			gd.PushError(gd.NewVariant(fmt.Sprintf("%v: invalid default %q: %v", value.Type().FieldByIndex(tag.index).Name, tag.raw, err)))
+			continue
+		}
+		if obj, ok := decoded.Interface().(interface{ AsObject() [1]gd.Object }); ok && isObjectType(field.Type()) {
+			if ref, ok := gd.As[gd.RefCounted](obj.AsObject()[0]); ok {
+				ref.Reference()
+			}
+			pointers.Pin(obj.AsObject()[0])
+		}
+		field.Set(decoded)
+	}
+}
+
+// parseDefault parses the value of a 'default' tag.
+func parseDefault(raw string) defaultTag {
+	p := literalParser{src: raw}
+	value, err := p.parse()
+	if err == nil {
+		p.space()
+		if p.pos < len(p.src) {
+			err = fmt.Errorf("unexpected %q after value", p.src[p.pos:])
+		}
+	}
+	return defaultTag{raw: raw, value: value, err: err}
+}
+
+type literalParser struct {
+	src string
+	pos int
+}
+
+func (p *literalParser) space() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *literalParser) peek() byte {
+	p.space()
+	if p.pos < len(p.src) {
+		return p.src[p.pos]
+	}
+	return 0
+}
+
+func (p *literalParser) expect(c byte) error {
+	if p.peek() != c {
+		if p.pos >= len(p.src) {
+			return fmt.Errorf("expected %q, found end of tag", c)
+		}
+		return fmt.Errorf("expected %q, found %q", c, p.src[p.pos])
+	}
+	p.pos++
+	return nil
+}
+
+func (p *literalParser) parse() (literal, error) {
+	switch c := p.peek(); {
+	case c == 0:
+		return literal{}, errors.New("missing value")
+	case c == '"':
+		s, err := p.quoted()
+		return literal{kind: literalString, text: s}, err
+	case c == '&' || c == '^':
+		p.pos++
+		s, err := p.quoted()
+		if c == '&' {
+			return literal{kind: literalStringName, text: s}, err
+		}
+		return literal{kind: literalNodePath, text: s}, err
+	case c == '[':
+		p.pos++
+		args, err := p.list(']', false)
+		return literal{kind: literalArray, args: args}, err
+	case c == '{':
+		p.pos++
+		args, err := p.list('}', true)
+		return literal{kind: literalDictionary, args: args}, err
+	case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+		start := p.pos
+		p.pos++
+		for p.pos < len(p.src) && (isIdentByte(p.src[p.pos]) || p.src[p.pos] == '.' ||
+			((p.src[p.pos] == '-' || p.src[p.pos] == '+') && (p.src[p.pos-1] == 'e' || p.src[p.pos-1] == 'E'))) {
+			p.pos++
+		}
+		return literal{kind: literalNumber, text: p.src[start:p.pos]}, nil
+	case isIdentByte(c):
+		start := p.pos
+		for p.pos < len(p.src) && (isIdentByte(p.src[p.pos]) || p.src[p.pos] == '.') {
+			p.pos++
+		}
+		if p.pos < len(p.src) && p.src[p.pos] == '[' { // typed constructor, ie. Array[int]
+			end := strings.IndexByte(p.src[p.pos:], ']')
+			if end < 0 {
+				return literal{}, errors.New("unterminated type parameter")
+			}
+			p.pos += end + 1
+		}
+		name := p.src[start:p.pos]
+		switch name {
+		case "inf", "nan":
+			return literal{kind: literalNumber, text: name}, nil
+		}
+		if p.peek() != '(' {
+			return literal{kind: literalIdent, text: name}, nil
+		}
+		p.pos++
+		args, err := p.list(')', false)
+		return literal{kind: literalCall, text: name, args: args}, err
+	default:
+		return literal{}, fmt.Errorf("unexpected %q", c)
+	}
+}
+
+// list parses a comma separated list of values, up until the given closing delimiter.
+func (p *literalParser) list(end byte, pairs bool) ([]literal, error) {
+	var args []literal
+	for {
+		if p.peek() == end {
+			p.pos++
+			return args, nil
+		}
+		arg, err := p.parse()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if pairs {
+			if err := p.expect(':'); err != nil {
+				return nil, err
+			}
+			val, err := p.parse()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, val)
+		}
+		if p.peek() == ',' {
+			p.pos++
+			continue
+		}
+		if err := p.expect(end); err != nil {
+			return nil, err
+		}
+		return args, nil
+	}
+}
+
+func (p *literalParser) quoted() (string, error) {
+	if p.pos >= len(p.src) || p.src[p.pos] != '"' {
+		return "", errors.New("expected a quoted string")
+	}
+	for i := p.pos + 1; i < len(p.src); i++ {
+		switch p.src[i] {
+		case '\\':
+			i++
+		case '"':
+			s, err := strconv.Unquote(p.src[p.pos : i+1])
+			p.pos = i + 1
+			return s, err
+		}
+	}
+	return "", errors.New("unterminated string")
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// isObjectType reports whether values of rtype are engine objects.
+func isObjectType(rtype reflect.Type) bool {
+	return (rtype.Kind() == reflect.Array || rtype.Kind() == reflect.Pointer) && rtype.Implements(reflect.TypeFor[interface{ AsObject() [1]gd.Object }]())
+}
+
+// decodeLiteral converts the literal into a value of the given type.
+func decodeLiteral(rtype reflect.Type, lit literal) (reflect.Value, error) {
+	value := reflect.New(rtype).Elem()
+	if lit.kind == literalIdent && lit.text == "null" {
+		return value, nil
+	}
+	if reflect.PointerTo(rtype).Implements(reflect.TypeFor[Enum.Pointer]()) {
+		return value, decodeEnum(value, lit)
+	}
+	if isObjectType(rtype) {
+		return decodeResource(rtype, lit)
+	}
+	if lit.kind == literalCall && lit.text != "" {
+		if err := checkConstructor(rtype, lit.text); err != nil {
+			return value, err
+		}
+	}
+	if rtype == reflect.TypeFor[Color.RGBA]() {
+		if lit.kind == literalString || (lit.kind == literalCall && len(lit.args) == 1 && lit.args[0].kind == literalString) {
+			if lit.kind == literalCall {
+				lit = lit.args[0]
+			}
+			if color := Color.String(lit.text); color != Color.Transparent || strings.EqualFold(lit.text, "transparent") {
+				return reflect.ValueOf(color), nil
+			}
+			return value, fmt.Errorf("unknown color %q", lit.text)
+		}
+		if lit.kind == literalCall && len(lit.args) == 3 {
+			lit.args = append(lit.args, literal{kind: literalNumber, text: "1"})
+		}
+	}
+	if method, ok := reflect.PointerTo(rtype).MethodByName("Append"); ok && rtype.Kind() == reflect.Struct && method.Type.NumIn() == 2 {
+		elems, err := elementsOf(method.Type.In(1), lit)
+		if err != nil {
+			return value, err
+		}
+		for _, elem := range elems {
+			decoded, err := decodeLiteral(method.Type.In(1), elem)
+			if err != nil {
+				return value, err
+			}
+			value.Addr().MethodByName("Append").Call([]reflect.Value{decoded})
+		}
+		return value, nil
+	}
+	if set, ok := reflect.PointerTo(rtype).MethodByName("SetIndex"); ok && rtype.Kind() == reflect.Struct && set.Type.NumIn() == 3 {
+		if lit.kind == literalCall && len(lit.args) == 1 {
+			lit = lit.args[0] // Dictionary[K, V]({...})
+		}
+		if lit.kind != literalDictionary {
+			return value, fmt.Errorf("expected a dictionary for %v", rtype)
+		}
+		for i := 0; i < len(lit.args); i += 2 {
+			key, err := decodeLiteral(set.Type.In(1), lit.args[i])
+			if err != nil {
+				return value, err
+			}
+			val, err := decodeLiteral(set.Type.In(2), lit.args[i+1])
+			if err != nil {
+				return value, err
+			}
+			value.Addr().MethodByName("SetIndex").Call([]reflect.Value{key, val})
+		}
+		return value, nil
+	}
+	if unmarshaler, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		if s, ok := stringOf(lit); ok || lit.kind == literalIdent {
+			if !ok {
+				s = lit.text
+			}
+			return value, unmarshaler.UnmarshalText([]byte(s))
+		}
+	}
+	switch rtype.Kind() {
+	case reflect.Bool:
+		if lit.kind != literalIdent || (lit.text != "true" && lit.text != "false") {
+			return value, fmt.Errorf("expected true or false for %v", rtype)
+		}
+		value.SetBool(lit.text == "true")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if lit.kind != literalNumber {
+			return value, fmt.Errorf("expected an integer for %v", rtype)
+		}
+		i, err := strconv.ParseInt(lit.text, 0, rtype.Bits())
+		if err != nil {
+			f, ferr := decodeWholeFloat(lit)
+			if ferr != nil || value.OverflowInt(int64(f)) {
+				return value, err
+			}
+			i = int64(f)
+		}
+		value.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if lit.kind != literalNumber {
+			return value, fmt.Errorf("expected an unsigned integer for %v", rtype)
+		}
+		u, err := strconv.ParseUint(lit.text, 0, rtype.Bits())
+		if err != nil {
+			f, ferr := decodeWholeFloat(lit)
+			if ferr != nil || f < 0 || value.OverflowUint(uint64(f)) {
+				return value, err
+			}
+			u = uint64(f)
+		}
+		value.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := decodeFloat(lit)
+		if err != nil {
+			return value, err
+		}
+		value.SetFloat(f)
+	case reflect.Complex64, reflect.Complex128:
+		if lit.kind == literalCall && len(lit.args) == 2 { // Vector2(real, imag)
+			re, err := decodeFloat(lit.args[0])
+			if err != nil {
+				return value, err
+			}
+			im, err := decodeFloat(lit.args[1])
+			if err != nil {
+				return value, err
+			}
+			value.SetComplex(complex(re, im))
+			return value, nil
+		}
+		f, err := decodeFloat(lit)
+		if err != nil {
+			return value, err
+		}
+		value.SetComplex(complex(f, 0))
+	case reflect.String:
+		s, ok := stringOf(lit)
+		if !ok && lit.kind != literalIdent {
+			return value, fmt.Errorf("expected a string for %v", rtype)
+		}
+		if !ok {
+			s = lit.text
+		}
+		value.SetString(s)
+	case reflect.Struct:
+		return value, decodeStruct(value, lit)
+	case reflect.Array:
+		elems, err := elementsOf(rtype.Elem(), lit)
+		if err != nil {
+			return value, err
+		}
+		if len(elems) > rtype.Len() {
+			return value, fmt.Errorf("too many elements for %v", rtype)
+		}
+		for i, elem := range elems {
+			decoded, err := decodeLiteral(rtype.Elem(), elem)
+			if err != nil {
+				return value, err
+			}
+			value.Index(i).Set(decoded)
+		}
+	case reflect.Slice:
+		elems, err := elementsOf(rtype.Elem(), lit)
+		if err != nil {
+			return value, err
+		}
+		value.Set(reflect.MakeSlice(rtype, len(elems), len(elems)))
+		for i, elem := range elems {
+			decoded, err := decodeLiteral(rtype.Elem(), elem)
+			if err != nil {
+				return value, err
+			}
+			value.Index(i).Set(decoded)
+		}
+	case reflect.Map:
+		if lit.kind == literalCall && len(lit.args) == 1 {
+			lit = lit.args[0] // Dictionary[K, V]({...})
+		}
+		if lit.kind != literalDictionary {
+			return value, fmt.Errorf("expected a dictionary for %v", rtype)
+		}
+		value.Set(reflect.MakeMapWithSize(rtype, len(lit.args)/2))
+		for i := 0; i < len(lit.args); i += 2 {
+			key, err := decodeLiteral(rtype.Key(), lit.args[i])
+			if err != nil {
+				return value, err
+			}
+			val, err := decodeLiteral(rtype.Elem(), lit.args[i+1])
+			if err != nil {
+				return value, err
+			}
+			value.SetMapIndex(key, val)
+		}
+	case reflect.Pointer:
+		elem, err := decodeLiteral(rtype.Elem(), lit)
+		if err != nil {
+			return value, err
+		}
+		value.Set(reflect.New(rtype.Elem()))
+		value.Elem().Set(elem)
+	case reflect.Interface:
+		if rtype.NumMethod() != 0 {
+			return value, fmt.Errorf("unsupported default type %v", rtype)
+		}
+		var natural any
+		switch lit.kind {
+		case literalNumber:
+			if i, err := strconv.ParseInt(lit.text, 0, 64); err == nil {
+				natural = i
+			} else if f, err := decodeFloat(lit); err == nil {
+				natural = f
+			} else {
+				return value, err
+			}
+		case literalString, literalStringName, literalNodePath:
+			natural = lit.text
+		case literalIdent:
+			if lit.text != "true" && lit.text != "false" {
+				return value, fmt.Errorf("unknown identifier %q", lit.text)
+			}
+			natural = lit.text == "true"
+		default:
+			return value, errors.New("cannot infer the type of a constructor, use a typed field instead")
+		}
+		value.Set(reflect.ValueOf(natural))
+	default:
+		return value, fmt.Errorf("unsupported default type %v", rtype)
+	}
+	return value, nil
+}
+
+// checkConstructor returns an error if the named constructor does not construct values of the
+// variant type of rtype, ie. Color(1, 2, 3) for a Vector3 field. Arrays may be constructed by any
+// of the array constructors, strings by String, StringName or NodePath and plain Go structs by
+// the name of their type.
+func checkConstructor(rtype reflect.Type, name string) error {
+	name, _, _ = strings.Cut(name, "[") // ie. Array[int] or Dictionary[String, int]
+	vtype, ok := gd.VariantTypeOf(rtype)
+	if !ok || vtype == gd.TypeNil {
+		return nil
+	}
+	expect := variant.Type(vtype).String()
+	switch {
+	case strings.EqualFold(name, expect):
+	case isStringLike(rtype) && (name == "String" || name == "StringName" || name == "NodePath"):
+	case isArrayConstructor(expect) && isArrayConstructor(name):
+	case vtype == gd.TypeDictionary && rtype.Kind() == reflect.Struct && name == rtype.Name():
+	default:
+		return fmt.Errorf("cannot use %s(...) for %v, expected %s(...)", name, rtype, expect)
+	}
+	return nil
+}
+
+// isArrayConstructor reports whether name is Array or one of the Packed*Array constructors.
+func isArrayConstructor(name string) bool {
+	return name == "Array" || (strings.HasPrefix(name, "Packed") && strings.HasSuffix(name, "Array"))
+}
+
+// isStringLike reports whether rtype is a string, or a type that can be unmarshaled from text,
+// such as [String.Readable] or [Path.ToNode].
+func isStringLike(rtype reflect.Type) bool {
+	if reflect.PointerTo(rtype).Implements(reflect.TypeFor[Enum.Pointer]()) {
+		return false
+	}
+	return rtype.Kind() == reflect.String || reflect.PointerTo(rtype).Implements(reflect.TypeFor[encoding.TextUnmarshaler]())
+}
+
+// stringOf returns the contents of a string literal, ie. "text", &"name", ^"path" or NodePath("path").
+func stringOf(lit literal) (string, bool) {
+	if lit.kind == literalCall && len(lit.args) == 1 {
+		switch lit.text {
+		case "String", "StringName", "NodePath":
+			lit = lit.args[0]
+		}
+	}
+	switch lit.kind {
+	case literalString, literalStringName, literalNodePath:
+		return lit.text, true
+	}
+	return "", false
+}
+
+func decodeFloat(lit literal) (float64, error) {
+	if lit.kind != literalNumber {
+		return 0, errors.New("expected a number")
+	}
+	switch lit.text {
+	case "inf", "+inf":
+		return math.Inf(1), nil
+	case "-inf":
+		return math.Inf(-1), nil
+	case "nan":
+		return math.NaN(), nil
+	}
+	return strconv.ParseFloat(lit.text, 64)
+}
+
+// decodeWholeFloat returns the value of a float literal with no fractional part, such that
+// integer fields accept 1.0, just as float fields accept 1.
+func decodeWholeFloat(lit literal) (float64, error) {
+	f, err := decodeFloat(lit)
+	if err != nil {
+		return 0, err
+	}
+	if f != math.Trunc(f) || math.IsInf(f, 0) || math.Abs(f) >= 1<<63 {
+		return 0, fmt.Errorf("%v is not a whole number", lit.text)
+	}
+	return f, nil
+}
+
+// decodeEnum accepts either the name of the enum value or its integer value.
+func decodeEnum(value reflect.Value, lit literal) error {
+	enum := value.Addr().Interface().(Enum.Pointer)
+	switch lit.kind {
+	case literalNumber:
+		i, err := strconv.Atoi(lit.text)
+		if err != nil {
+			return err
+		}
+		enum.SetInt(i)
+		return nil
+	case literalIdent, literalString:
+		if _, ok := enum.(Enum.Bitfield); ok {
+			if err := value.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(lit.text)); err != nil {
+				return fmt.Errorf("%q is not a valid %v", lit.text, value.Type())
+			}
+			return nil
+		}
+		for name, i := range enum.Enum {
+			if name == lit.text || strings.EqualFold(name, lit.text) {
+				enum.SetInt(i)
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not a valid %v", lit.text, value.Type())
+	default:
+		return fmt.Errorf("expected the name of a %v", value.Type())
+	}
+}
+
+// decodeResource loads the resource at the given path, ie. "res://icon.svg" or Resource("res://icon.svg").
+func decodeResource(rtype reflect.Type, lit literal) (reflect.Value, error) {
+	path, err := resourcePathOf(rtype, lit)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	resource := ResourceLoaderClass.Load(path, "")
+	if resource == (ResourceClass.Instance{}) {
+		return reflect.Value{}, fmt.Errorf("cannot load %q", path)
+	}
+	return gd.ConvertToDesiredGoType(gd.NewVariant(resource), rtype)
+}
+
+// resourcePathOf returns the path of a resource literal, such that malformed tags can be reported
+// before the resource is loaded.
+func resourcePathOf(rtype reflect.Type, lit literal) (string, error) {
+	if lit.kind == literalCall && len(lit.args) == 1 {
+		switch lit.text {
+		case "Resource", "ExtResource", "load", "preload":
+			lit = lit.args[0]
+		}
+	}
+	if lit.kind != literalString {
+		return "", fmt.Errorf("expected a resource path for %v", rtype)
+	}
+	return lit.text, nil
+}
+
+// elementsOf returns the elements of an array literal, Packed*Array(...) constructors may
+// list the components of each element inline, ie. PackedVector2Array(1, 2, 3, 4)
+func elementsOf(elem reflect.Type, lit literal) ([]literal, error) {
+	switch lit.kind {
+	case literalArray:
+		return lit.args, nil
+	case literalCall:
+		if len(lit.args) == 1 && lit.args[0].kind == literalArray {
+			return lit.args[0].args, nil // Array[T]([...])
+		}
+		if n := numericLeaves(elem); n > 1 && allNumbers(lit.args) {
+			if len(lit.args)%n != 0 {
+				return nil, fmt.Errorf("%s expects a multiple of %d numbers", lit.text, n)
+			}
+			var elems []literal
+			for i := 0; i < len(lit.args); i += n {
+				elems = append(elems, literal{kind: literalCall, args: lit.args[i : i+n]})
+			}
+			return elems, nil
+		}
+		return lit.args, nil
+	default:
+		return nil, errors.New("expected an array")
+	}
+}
+
+// decodeStruct decodes either a constructor, with an argument for each field (or each numeric component
+// of the struct) or a dictionary, keyed by property names.
+func decodeStruct(value reflect.Value, lit literal) error {
+	rtype := value.Type()
+	switch lit.kind {
+	case literalCall, literalArray:
+		if len(lit.args) == rtype.NumField() {
+			for i, arg := range lit.args {
+				if !rtype.Field(i).IsExported() {
+					return fmt.Errorf("cannot construct %v", rtype)
+				}
+				decoded, err := decodeLiteral(rtype.Field(i).Type, arg)
+				if err != nil {
+					return err
+				}
+				value.Field(i).Set(decoded)
+			}
+			return nil
+		}
+		if n := numericLeaves(rtype); n == len(lit.args) && allNumbers(lit.args) {
+			args := lit.args
+			return setLeaves(value, &args)
+		}
+		return fmt.Errorf("wrong number of arguments for %v", rtype)
+	case literalDictionary:
+		for i := 0; i < len(lit.args); i += 2 {
+			key := lit.args[i]
+			if key.kind != literalString && key.kind != literalStringName && key.kind != literalIdent {
+				return fmt.Errorf("expected a field name for %v", rtype)
+			}
+			field, ok := lookupProperty(rtype, key.text)
+			if !ok || !field.IsExported() {
+				return fmt.Errorf("%v has no field %q", rtype, key.text)
+			}
+			decoded, err := decodeLiteral(field.Type, lit.args[i+1])
+			if err != nil {
+				return err
+			}
+			value.FieldByIndex(field.Index).Set(decoded)
+		}
+		return nil
+	default:
+		return fmt.Errorf("expected a constructor for %v", rtype)
+	}
+}
+
+// numericLeaves returns the number of numeric components in rtype, or -1 if rtype contains non-numeric values.
+func numericLeaves(rtype reflect.Type) int {
+	switch rtype.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return 1
+	case reflect.Struct:
+		var n int
+		for i := range rtype.NumField() {
+			leaves := numericLeaves(rtype.Field(i).Type)
+			if leaves < 0 || !rtype.Field(i).IsExported() {
+				return -1
+			}
+			n += leaves
+		}
+		return n
+	case reflect.Array:
+		if leaves := numericLeaves(rtype.Elem()); leaves >= 0 {
+			return leaves * rtype.Len()
+		}
+	}
+	return -1
+}
+
+func setLeaves(value reflect.Value, args *[]literal) error {
+	switch value.Kind() {
+	case reflect.Struct:
+		for i := range value.NumField() {
+			if err := setLeaves(value.Field(i), args); err != nil {
+				return err
+			}
+		}
+	case reflect.Array:
+		for i := range value.Len() {
+			if err := setLeaves(value.Index(i), args); err != nil {
+				return err
+			}
+		}
+	default:
+		decoded, err := decodeLiteral(value.Type(), (*args)[0])
+		if err != nil {
+			return err
+		}
+		value.Set(decoded)
+		*args = (*args)[1:]
+	}
+	return nil
+}
+
+func allNumbers(args []literal) bool {
+	for _, arg := range args {
+		if arg.kind != literalNumber {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package classdb
+
+import (
+	"reflect"
+	"testing"
+
+	"graphics.gd/variant/Color"
+	"graphics.gd/variant/Vector3"
+)
+
+func TestDefaultConstructors(t *testing.T) {
+	for _, tc := range []struct {
+		rtype reflect.Type
+		tag   string
+		valid bool
+	}{
+		{reflect.TypeFor[Vector3.XYZ](), "Vector3(1, 2, 3)", true},
+		{reflect.TypeFor[Vector3.XYZ](), "Color(1, 2, 3)", false},
+		{reflect.TypeFor[float64](), "Vector3(1, 2, 3)", false},
+		{reflect.TypeFor[Color.RGBA](), "Color(1, 0, 0)", true},
+		{reflect.TypeFor[Color.RGBA](), "Vector3(1, 0, 0)", false},
+		{reflect.TypeFor[complex128](), "Vector2(1, 2)", true},
+		{reflect.TypeFor[string](), "StringName(\"name\")", true},
+		{reflect.TypeFor[[]int](), "Array[int]([1, 2])", true},
+		{reflect.TypeFor[[]float32](), "PackedFloat32Array(1, 2)", true},
+		{reflect.TypeFor[[]int](), "Dictionary({})", false},
+		{reflect.TypeFor[map[string]int](), "Dictionary[String, int]({\"a\": 1})", true},
+		{reflect.TypeFor[[]Vector3.XYZ](), "PackedVector3Array(1, 2, 3, 4, 5, 6)", true},
+	} {
+		tag := parseDefault(tc.tag)
+		_, err := tag.decode(tc.rtype)
+		if valid := err == nil; valid != tc.valid {
+			t.Errorf("%v `default:%q`: expected valid=%v, got %v", tc.rtype, tc.tag, tc.valid, err)
+		}
+	}
+}
+
+func TestDefaultNumbers(t *testing.T) {
+	for _, tc := range []struct {
+		rtype reflect.Type
+		tag   string
+		want  any
+	}{
+		{reflect.TypeFor[int](), "1.0", 1},
+		{reflect.TypeFor[int](), "-2e2", -200},
+		{reflect.TypeFor[int](), "1.5", nil},
+		{reflect.TypeFor[int8](), "300.0", nil},
+		{reflect.TypeFor[uint](), "3.0", uint(3)},
+		{reflect.TypeFor[uint](), "-1.0", nil},
+		{reflect.TypeFor[float64](), "1", 1.0},
+	} {
+		value, err := parseDefault(tc.tag).decode(tc.rtype)
+		if tc.want == nil {
+			if err == nil {
+				t.Errorf("%v `default:%q`: expected an error, got %v", tc.rtype, tc.tag, value)
+			}
+			continue
+		}
+		if err != nil || value.Interface() != tc.want {
+			t.Errorf("%v `default:%q`: expected %v, got %v (%v)", tc.rtype, tc.tag, tc.want, value, err)
+		}
+	}
+}
+
+func TestDefaultOf(t *testing.T) {
+	type Movement struct {
+		Speed float64 `default:"2.5"`
+	}
+	type Player struct {
+		Health   int `default:"3"`
+		Movement Movement
+	}
+	defaults := defaultsOf("Player", reflect.TypeFor[Player]())
+	field, ok := lookupProperty(reflect.TypeFor[Player](), "movement/speed")
+	if !ok {
+		t.Fatal("expected movement/speed to be a property")
+	}
+	tag, ok := defaultOf(defaults, field.Index)
+	if !ok {
+		t.Fatalf("expected a default for %v, got %v", field.Index, defaults)
+	}
+	if value, err := tag.decodeField(field.Type); err != nil || value.Interface() != 2.5 {
+		t.Fatalf("expected 2.5, got %v (%v)", value, err)
+	}
+	if _, ok := defaultOf(defaults, []int{1}); ok {
+		t.Fatal("expected no default for the group itself")
+	}
+}
@@ -0,0 +1,235 @@
+package classdb
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	ClassDBClass "graphics.gd/classdb/ClassDB"
+	EditorInspectorPluginClass "graphics.gd/classdb/EditorInspectorPlugin"
+	EditorPluginClass "graphics.gd/classdb/EditorPlugin"
+	EditorPropertyClass "graphics.gd/classdb/EditorProperty"
+
+	"graphics.gd/variant"
+	"graphics.gd/variant/Object"
+	"graphics.gd/variant/Signal"
+	"graphics.gd/variant/String"
+
+	gd "graphics.gd/internal"
+	"graphics.gd/internal/pointers"
+)
+
+// ToolButton adds a clickable button to the inspector of a [Tool] class, that calls a method of the
+// class when pressed (equivalent to GDScript's @export_tool_button annotation). Pass a
+// map[string]ToolButton keyed by method name to [Register], the method must not accept any arguments.
+//
+//	classdb.Register[Spawner](map[string]classdb.ToolButton{
+//		"Respawn": {Text: "Respawn Enemies", Icon: "Reload"},
+//	})
+//
+// A func() field can be shown as a button with the 'button' tag instead, ie.
+//
+//	Reset func() `button:"Reset Position,Reload"`
+type ToolButton struct {
+	Text string // text of the button, defaults to the name of the method.
+	Icon string // optional name of an editor icon, ie. "Reload"
+}
+
+// hint returns the hint string of the button.
+func (button ToolButton) hint() string {
+	if button.Icon != "" {
+		return button.Text + "," + button.Icon
+	}
+	return button.Text
+}
+
+// registerButtons registers a tool button property for each of the given methods, returning a
+// map of property names to method names.
+func registerButtons(className gd.StringName, class reflect.Type, tool bool, buttons map[string]ToolButton, renames map[uintptr]string) map[string]string {
+	if !tool {
+		if len(buttons) > 0 {
+			panic(fmt.Sprintf("gdextension.RegisterClass: %v must embed classdb.Tool in order to add inspector buttons", class.Name()))
+		}
+		walkProperties(class, "", func(path string, field reflect.StructField) {
+			if _, ok := field.Tag.Lookup("button"); ok {
+				panic(fmt.Sprintf("gdextension.RegisterClass: %v must embed classdb.Tool in order to add the %v button", class.Name(), path))
+			}
+		})
+		return nil
+	}
+	if len(buttons) == 0 {
+		return nil
+	}
+	var resolved = make(map[string]string, len(buttons))
+	for name, button := range buttons {
+		method := buttonMethod(class, name)
+		property := String.ToSnakeCase(method.Name)
+		if rename, ok := renames[method.Func.Pointer()]; ok {
+			property = rename
+		}
+		if button.Text == "" {
+			button.Text = String.ToPascalCase(property)
+		}
+		resolved[property] = method.Name
+		gd.Global.ClassDB.RegisterClassProperty(gd.Global.ExtensionToken, className, gd.PropertyInfo{
+			Type:       gd.TypeCallable,
+			Name:       gd.NewStringName(property),
+			ClassName:  gd.NewStringName(""),
+			Hint:       int64(PropertyHintToolButton),
+			HintString: gd.NewString(button.hint()),
+			Usage:      int64(PropertyUsageEditor),
+		}, gd.NewStringName(""), gd.NewStringName(""))
+	}
+	return resolved
+}
+
+// buttonMethod returns the method of the class with the given name, to add as a button.
+func buttonMethod(class reflect.Type, name string) reflect.Method {
+	rtype := reflect.PointerTo(class)
+	method, ok := rtype.MethodByName(name)
+	if !ok {
+		method, ok = rtype.MethodByName(String.ToPascalCase(name))
+	}
+	if !ok {
+		panic(fmt.Sprintf("gdextension.RegisterClass: %v has no %v method to add as a button", class.Name(), name))
+	}
+	if method.Type.NumIn() != 1 {
+		panic(fmt.Sprintf("gdextension.RegisterClass: %v.%v must not accept any arguments in order to be added as a button", class.Name(), method.Name))
+	}
+	return method
+}
+
+// walkProperties calls fn for each field of the given struct type that is exported
+// as a property, along with the property path of the field.
+func walkProperties(rtype reflect.Type, prefix string, fn func(path string, field reflect.StructField)) {
+	for i := range rtype.NumField() {
+		field := rtype.Field(i)
+		if !field.IsExported() || field.Name == "Object" {
+			continue
+		}
+		if field.Anonymous {
+			if field.Type.Kind() == reflect.Struct {
+				walkProperties(field.Type, prefix, fn)
+			}
+			continue
+		}
+		if _, ok := field.Type.MethodByName("AsNode"); ok || field.Type.Kind() == reflect.Chan ||
+			reflect.PointerTo(field.Type).Implements(reflect.TypeFor[Signal.Pointer]()) {
+			continue
+		}
+		name := String.ToSnakeCase(field.Name)
+		if tag := field.Tag.Get("gd"); tag != "" {
+			name = tag
+		}
+		if isPropertyGroup(field.Type) {
+			walkProperties(field.Type, prefix+name+"/", fn)
+			continue
+		}
+		fn(prefix+name, field)
+	}
+}
+
+// PropertyEditor can be implemented by the type of an exported field, in order to edit the field
+// in the inspector with a custom [EditorPropertyClass.Instance] instead of the built-in editor.
+// PropertyEditor is called on the zero value of the type, each time that the inspector shows
+// the field. The editor must be a class registered with [Register].
+//
+//	type Health int
+//
+//	func (Health) PropertyEditor() EditorProperty.Instance {
+//		return new(HealthBar).AsEditorProperty()
+//	}
+type PropertyEditor interface {
+	PropertyEditor() EditorPropertyClass.Instance
+}
+
+// propertyEditors maps each registered class type to the property paths of its
+// fields that have a custom [PropertyEditor].
+var propertyEditors sync.Map // map[reflect.Type]map[string]reflect.Type
+
+// propertyEditorsOf returns the property paths of the fields of the given class that
+// have a custom [PropertyEditor].
+func propertyEditorsOf(class reflect.Type) map[string]reflect.Type {
+	var editors map[string]reflect.Type
+	walkProperties(class, "", func(path string, field reflect.StructField) {
+		if field.Type.Implements(reflect.TypeFor[PropertyEditor]()) {
+			if editors == nil {
+				editors = make(map[string]reflect.Type)
+			}
+			editors[path] = field.Type
+		}
+	})
+	return editors
+}
+
+// registerPropertyEditors registers the editor plugin that adds the custom property
+// editors of the given class to the inspector.
+func registerPropertyEditors(class reflect.Type) {
+	editors := propertyEditorsOf(class)
+	if len(editors) == 0 {
+		return
+	}
+	propertyEditors.Store(class, editors)
+	inspectorPlugin.Do(func() {
+		Register[goInspectorPlugin]()
+		Register[goInspectorEditorPlugin]()
+	})
+}
+
+var inspectorPlugin sync.Once
+
+// goInspectorPlugin adds the custom [PropertyEditor] of each field to the inspector.
+type goInspectorPlugin struct {
+	EditorInspectorPluginClass.Extension[goInspectorPlugin] `gd:"GoInspectorPlugin"`
+}
+
+// editorsFor returns the custom property editors of the given object.
+func (*goInspectorPlugin) editorsFor(obj Object.Instance) map[string]reflect.Type {
+	instance, ok := gd.ExtensionInstances.Load(pointers.Get(obj.AsObject()[0])[0])
+	if !ok {
+		return nil
+	}
+	rtype := reflect.TypeOf(instance)
+	if rtype.Kind() == reflect.Pointer {
+		rtype = rtype.Elem()
+	}
+	editors, _ := propertyEditors.Load(rtype)
+	result, _ := editors.(map[string]reflect.Type)
+	return result
+}
+
+func (plugin *goInspectorPlugin) CanHandle(obj Object.Instance) bool {
+	return len(plugin.editorsFor(obj)) > 0
+}
+
+func (plugin *goInspectorPlugin) ParseProperty(obj Object.Instance, atype variant.Type, name string, hint_type ClassDBClass.PropertyHint, hint_string string, usage_flags ClassDBClass.PropertyUsageFlags, wide bool) bool {
+	rtype, ok := plugin.editorsFor(obj)[name]
+	if !ok {
+		return false
+	}
+	editor := reflect.Zero(rtype).Interface().(PropertyEditor).PropertyEditor()
+	if editor == (EditorPropertyClass.Instance{}) {
+		return false
+	}
+	plugin.AsEditorInspectorPlugin().AddPropertyEditor(name, editor.AsControl())
+	return true
+}
+
+// goInspectorEditorPlugin adds the [goInspectorPlugin] to the editor.
+type goInspectorEditorPlugin struct {
+	EditorPluginClass.Extension[goInspectorEditorPlugin] `gd:"GoInspectorEditorPlugin"`
+
+	inspector *goInspectorPlugin
+}
+
+func (plugin *goInspectorEditorPlugin) EnterTree() {
+	plugin.inspector = new(goInspectorPlugin)
+	plugin.AsEditorPlugin().AddInspectorPlugin(plugin.inspector.AsEditorInspectorPlugin())
+}
+
+func (plugin *goInspectorEditorPlugin) ExitTree() {
+	if plugin.inspector != nil {
+		plugin.AsEditorPlugin().RemoveInspectorPlugin(plugin.inspector.AsEditorInspectorPlugin())
+		plugin.inspector = nil
+	}
+}
@@ -23,6 +23,7 @@ func registerEnumsFor(class gd.StringName, rtype reflect.Type) iter.Seq2[string,
 	var enumName = gd.NewStringName(rtype.Name())
 	defer enumName.Free()
 	var reference = reflect.New(rtype).Interface().(Enum.Any)
+	_, isBitfield := reference.(Enum.Bitfield)
 	for name, value := range reference.Enum {
 		gd.Global.ClassDB.RegisterClassIntegerConstant(
 			gd.Global.ExtensionToken,
@@ -30,7 +31,7 @@ func registerEnumsFor(class gd.StringName, rtype reflect.Type) iter.Seq2[string,
 			enumName,
 			gd.NewStringName(name),
 			int64(value),
-			false,
+			isBitfield,
 		)
 	}
 	registered_enums[rtype] = reference.Enum
@@ -0,0 +1,246 @@
+package classdb
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	gd "graphics.gd/internal"
+)
+
+// propertyHints maps the names accepted by the 'hint' struct tag to their [PropertyHint],
+// the names follow the engine's PROPERTY_HINT_* constants in snake_case, along with the
+// shorter names used by the equivalent GDScript @export_* annotations.
+var propertyHints = map[string]PropertyHint{
+	"none":                     PropertyHintNone,
+	"range":                    PropertyHintRange,
+	"enum":                     PropertyHintEnum,
+	"enum_suggestion":          PropertyHintEnumSuggestion,
+	"exp_easing":               PropertyHintExpEasing,
+	"link":                     PropertyHintLink,
+	"flags":                    PropertyHintFlags,
+	"layers_2d_render":         PropertyHintLayers2dRender,
+	"layers_2d_physics":        PropertyHintLayers2dPhysics,
+	"layers_2d_navigation":     PropertyHintLayers2dNavigation,
+	"layers_3d_render":         PropertyHintLayers3dRender,
+	"layers_3d_physics":        PropertyHintLayers3dPhysics,
+	"layers_3d_navigation":     PropertyHintLayers3dNavigation,
+	"layers_avoidance":         PropertyHintLayersAvoidance,
+	"file":                     PropertyHintFile,
+	"dir":                      PropertyHintDir,
+	"global_file":              PropertyHintGlobalFile,
+	"global_dir":               PropertyHintGlobalDir,
+	"resource_type":            PropertyHintResourceType,
+	"multiline_text":           PropertyHintMultilineText,
+	"expression":               PropertyHintExpression,
+	"placeholder_text":         PropertyHintPlaceholderText,
+	"color_no_alpha":           PropertyHintColorNoAlpha,
+	"object_id":                PropertyHintObjectId,
+	"type_string":              PropertyHintTypeString,
+	"node_path_to_edited_node": PropertyHintNodePathToEditedNode,
+	"object_too_big":           PropertyHintObjectTooBig,
+	"node_path_valid_types":    PropertyHintNodePathValidTypes,
+	"save_file":                PropertyHintSaveFile,
+	"global_save_file":         PropertyHintGlobalSaveFile,
+	"int_is_objectid":          PropertyHintIntIsObjectid,
+	"int_is_pointer":           PropertyHintIntIsPointer,
+	"array_type":               PropertyHintArrayType,
+	"locale_id":                PropertyHintLocaleId,
+	"localizable_string":       PropertyHintLocalizableString,
+	"node_type":                PropertyHintNodeType,
+	"hide_quaternion_edit":     PropertyHintHideQuaternionEdit,
+	"password":                 PropertyHintPassword,
+	"dictionary_type":          PropertyHintDictionaryType,
+	"tool_button":              PropertyHintToolButton,
+	"oneshot":                  PropertyHintOneshot,
+
+	// GDScript @export_* spellings.
+	"multiline":           PropertyHintMultilineText,
+	"placeholder":         PropertyHintPlaceholderText,
+	"node_path":           PropertyHintNodePathValidTypes,
+	"flags_2d_render":     PropertyHintLayers2dRender,
+	"flags_2d_physics":    PropertyHintLayers2dPhysics,
+	"flags_2d_navigation": PropertyHintLayers2dNavigation,
+	"flags_3d_render":     PropertyHintLayers3dRender,
+	"flags_3d_physics":    PropertyHintLayers3dPhysics,
+	"flags_3d_navigation": PropertyHintLayers3dNavigation,
+	"flags_avoidance":     PropertyHintLayersAvoidance,
+}
+
+// propertyUsages maps the names accepted by the 'usage' struct tag to their [PropertyUsageFlags],
+// the names follow the engine's PROPERTY_USAGE_* constants in snake_case.
+var propertyUsages = map[string]PropertyUsageFlags{
+	"none":                      PropertyUsageNone,
+	"storage":                   PropertyUsageStorage,
+	"editor":                    PropertyUsageEditor,
+	"internal":                  PropertyUsageInternal,
+	"checkable":                 PropertyUsageCheckable,
+	"checked":                   PropertyUsageChecked,
+	"group":                     PropertyUsageGroup,
+	"category":                  PropertyUsageCategory,
+	"subgroup":                  PropertyUsageSubgroup,
+	"class_is_bitfield":         PropertyUsageClassIsBitfield,
+	"no_instance_state":         PropertyUsageNoInstanceState,
+	"restart_if_changed":        PropertyUsageRestartIfChanged,
+	"script_variable":           PropertyUsageScriptVariable,
+	"store_if_null":             PropertyUsageStoreIfNull,
+	"update_all_if_modified":    PropertyUsageUpdateAllIfModified,
+	"script_default_value":      PropertyUsageScriptDefaultValue,
+	"class_is_enum":             PropertyUsageClassIsEnum,
+	"nil_is_variant":            PropertyUsageNilIsVariant,
+	"array":                     PropertyUsageArray,
+	"always_duplicate":          PropertyUsageAlwaysDuplicate,
+	"never_duplicate":           PropertyUsageNeverDuplicate,
+	"high_end_gfx":              PropertyUsageHighEndGfx,
+	"node_path_from_scene_root": PropertyUsageNodePathFromSceneRoot,
+	"resource_not_persistent":   PropertyUsageResourceNotPersistent,
+	"keying_increments":         PropertyUsageKeyingIncrements,
+	"deferred_set_resource":     PropertyUsageDeferredSetResource,
+	"editor_instantiate_object": PropertyUsageEditorInstantiateObject,
+	"editor_basic_setting":      PropertyUsageEditorBasicSetting,
+	"read_only":                 PropertyUsageReadOnly,
+	"secret":                    PropertyUsageSecret,
+	"default":                   PropertyUsageDefault,
+	"no_editor":                 PropertyUsageNoEditor,
+}
+
+// propertyHintTypes restricts hints to the variant types that the editor is able to
+// apply them to, hints that are missing from this map are accepted for any type.
+var propertyHintTypes = map[PropertyHint][]gd.VariantType{
+	PropertyHintRange:                {gd.TypeInt, gd.TypeFloat, gd.TypeVector2, gd.TypeVector2i, gd.TypeVector3, gd.TypeVector3i, gd.TypeVector4, gd.TypeVector4i, gd.TypeRect2, gd.TypeRect2i, gd.TypeColor, gd.TypeQuaternion, gd.TypeArray, gd.TypePackedInt32Array, gd.TypePackedInt64Array, gd.TypePackedFloat32Array, gd.TypePackedFloat64Array},
+	PropertyHintEnum:                 {gd.TypeInt, gd.TypeString, gd.TypeStringName},
+	PropertyHintEnumSuggestion:       {gd.TypeString, gd.TypeStringName},
+	PropertyHintExpEasing:            {gd.TypeFloat},
+	PropertyHintLink:                 {gd.TypeVector2, gd.TypeVector2i, gd.TypeVector3, gd.TypeVector3i, gd.TypeVector4, gd.TypeVector4i},
+	PropertyHintFlags:                {gd.TypeInt},
+	PropertyHintLayers2dRender:       {gd.TypeInt},
+	PropertyHintLayers2dPhysics:      {gd.TypeInt},
+	PropertyHintLayers2dNavigation:   {gd.TypeInt},
+	PropertyHintLayers3dRender:       {gd.TypeInt},
+	PropertyHintLayers3dPhysics:      {gd.TypeInt},
+	PropertyHintLayers3dNavigation:   {gd.TypeInt},
+	PropertyHintLayersAvoidance:      {gd.TypeInt},
+	PropertyHintFile:                 {gd.TypeString, gd.TypePackedStringArray},
+	PropertyHintDir:                  {gd.TypeString, gd.TypePackedStringArray},
+	PropertyHintGlobalFile:           {gd.TypeString, gd.TypePackedStringArray},
+	PropertyHintGlobalDir:            {gd.TypeString, gd.TypePackedStringArray},
+	PropertyHintResourceType:         {gd.TypeObject},
+	PropertyHintMultilineText:        {gd.TypeString, gd.TypeStringName},
+	PropertyHintExpression:           {gd.TypeString},
+	PropertyHintPlaceholderText:      {gd.TypeString, gd.TypeStringName},
+	PropertyHintColorNoAlpha:         {gd.TypeColor},
+	PropertyHintSaveFile:             {gd.TypeString},
+	PropertyHintGlobalSaveFile:       {gd.TypeString},
+	PropertyHintIntIsObjectid:        {gd.TypeInt},
+	PropertyHintIntIsPointer:         {gd.TypeInt},
+	PropertyHintArrayType:            {gd.TypeArray},
+	PropertyHintLocaleId:             {gd.TypeString},
+	PropertyHintLocalizableString:    {gd.TypeDictionary},
+	PropertyHintNodeType:             {gd.TypeObject},
+	PropertyHintHideQuaternionEdit:   {gd.TypeQuaternion},
+	PropertyHintPassword:             {gd.TypeString},
+	PropertyHintDictionaryType:       {gd.TypeDictionary},
+	PropertyHintToolButton:           {gd.TypeCallable},
+	PropertyHintNodePathValidTypes:   {gd.TypeNodePath},
+	PropertyHintNodePathToEditedNode: {gd.TypeNodePath},
+}
+
+// propertyHintsWithRequiredString are the hints that are meaningless without a hint string.
+var propertyHintsWithRequiredString = map[PropertyHint]bool{
+	PropertyHintRange:          true,
+	PropertyHintEnum:           true,
+	PropertyHintEnumSuggestion: true,
+	PropertyHintFlags:          true,
+	PropertyHintTypeString:     true,
+	PropertyHintArrayType:      true,
+	PropertyHintDictionaryType: true,
+	PropertyHintToolButton:     true,
+}
+
+// propertyTags applies the 'range', 'hint', 'button', 'suffix' and 'usage' struct tags of the field on
+// top of the given hint, hint string and usage flags (as inferred from the Go type of the
+// field).
+//
+//	Speed     float64 `range:"0,100,0.1,or_greater" suffix:"m/s"`
+//	Texture   string  `hint:"file:*.png,*.jpg"`
+//	Notes     string  `hint:"multiline"`
+//	Tint      Color.RGBA `hint:"color_no_alpha"`
+//	Mask      int     `hint:"layers_2d_physics"`
+//	Internal  int     `usage:"storage"`
+//	Reset     func()  `button:"Reset Position,Reload"`
+//
+// The 'hint' tag is the name of the hint, optionally followed by a colon and the hint string.
+// The 'button' tag is the text of the button, optionally followed by a comma and the name of an editor icon.
+// The 'usage' tag is a comma separated list of usage flags that replace the default usage.
+func propertyTags(field reflect.StructField, vtype gd.VariantType, hint PropertyHint, hintString string, usage PropertyUsageFlags) (PropertyHint, string, PropertyUsageFlags, error) {
+	if rangeHint, ok := field.Tag.Lookup("range"); ok {
+		if strings.TrimSpace(rangeHint) == "" {
+			return hint, hintString, usage, fmt.Errorf("empty range tag (expected \"min,max\" or \"min,max,step\")")
+		}
+		hint = PropertyHintRange
+		hintString = rangeHint
+	}
+	if tag, ok := field.Tag.Lookup("hint"); ok {
+		if _, ok := field.Tag.Lookup("range"); ok {
+			return hint, hintString, usage, fmt.Errorf("range and hint tags cannot be combined (use hint:\"range:...\")")
+		}
+		name, value, hasValue := strings.Cut(tag, ":")
+		name = strings.TrimSpace(name)
+		tagged, ok := propertyHints[name]
+		if !ok {
+			return hint, hintString, usage, fmt.Errorf("unknown property hint %q", name)
+		}
+		if accepted, ok := propertyHintTypes[tagged]; ok && vtype != gd.TypeNil && !slices.Contains(accepted, vtype) {
+			return hint, hintString, usage, fmt.Errorf("property hint %q cannot be applied to a %v field", name, field.Type)
+		}
+		if propertyHintsWithRequiredString[tagged] && strings.TrimSpace(value) == "" {
+			return hint, hintString, usage, fmt.Errorf("property hint %q requires a value (hint:\"%s:...\")", name, name)
+		}
+		hint = tagged
+		switch {
+		case hasValue:
+			hintString = value
+		case tagged == PropertyHintResourceType, tagged == PropertyHintNodeType:
+			// keep the class name inferred from the field type.
+		default:
+			hintString = ""
+		}
+	}
+	if button, ok := field.Tag.Lookup("button"); ok {
+		if field.Type.Kind() != reflect.Func || field.Type.NumIn() != 0 {
+			return hint, hintString, usage, fmt.Errorf("button tag can only be applied to a func() field")
+		}
+		if strings.TrimSpace(button) == "" {
+			return hint, hintString, usage, fmt.Errorf("empty button tag (expected \"text\" or \"text,icon\")")
+		}
+		hint = PropertyHintToolButton
+		hintString = button
+		usage = PropertyUsageEditor
+	}
+	if suffix, ok := field.Tag.Lookup("suffix"); ok {
+		if suffix == "" {
+			return hint, hintString, usage, fmt.Errorf("empty suffix tag")
+		}
+		switch hint {
+		case PropertyHintRange:
+			hintString += ",suffix:" + suffix
+		case PropertyHintNone:
+			hintString = "suffix:" + suffix
+		default:
+			return hint, hintString, usage, fmt.Errorf("suffix tag can only be combined with a range hint")
+		}
+	}
+	if tag, ok := field.Tag.Lookup("usage"); ok {
+		usage = PropertyUsageNone
+		for name := range strings.SplitSeq(tag, ",") {
+			name = strings.TrimSpace(name)
+			flag, ok := propertyUsages[name]
+			if !ok {
+				return hint, hintString, usage, fmt.Errorf("unknown property usage %q", name)
+			}
+			usage |= flag
+		}
+	}
+	return hint, hintString, usage, nil
+}
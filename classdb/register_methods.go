@@ -2,6 +2,7 @@ package classdb
 
 import (
 	"fmt"
+	"iter"
 	"reflect"
 	"strings"
 
@@ -19,34 +20,12 @@ import (
 )
 
 func registerMethods(class gd.StringName, rtype reflect.Type, renames map[uintptr]string) {
-	classTypePtr := reflect.PointerTo(rtype)
-	for i := 0; i < classTypePtr.NumMethod(); i++ {
-		i := i
+	for name, method := range classMethods(rtype, renames) {
+		i := method.Index
 
 		var hasContext bool = false
 
-		method := classTypePtr.Method(i)
-		if !method.IsExported() || method.Type.NumIn() < 1 {
-			continue
-		}
-		if strings.HasPrefix(method.Name, "As") || method.Name == "Super" {
-			continue
-		}
-		parent, ok := rtype.FieldByName("Class")
-		if !ok {
-			panic(fmt.Sprintf("gdextension: %v does not have an embedded Class field", rtype))
-		}
-		if _, ok := reflect.PointerTo(parent.Type).MethodByName(method.Name); ok {
-			continue
-		}
-		if method.Name == "OnRegister" {
-			continue
-		}
-		if name, ok := renames[method.Func.Pointer()]; ok {
-			method.Name = name
-		} else {
-			method.Name = String.ToSnakeCase(method.Name)
-		}
+		method.Name = name
 		var offset = 0
 		var arguments = make([]gd.PropertyInfo, 0, method.Type.NumIn()-1-offset)
 		var metadatas = make([]gd.ClassMethodArgumentMetadata, 0, method.Type.NumIn()-1-offset)
@@ -81,6 +60,46 @@ func registerMethods(class gd.StringName, rtype reflect.Type, renames map[uintpt
 	}
 }
 
+// classMethods returns the methods of the class that are registered, keyed by their name within
+// the engine, such that [Register] and [Check] agree on which methods are registered.
+func classMethods(rtype reflect.Type, renames map[uintptr]string) iter.Seq2[string, reflect.Method] {
+	return func(yield func(string, reflect.Method) bool) {
+		ptype := reflect.PointerTo(rtype)
+		for i := range ptype.NumMethod() {
+			method := ptype.Method(i)
+			if !exportsMethod(rtype, method) {
+				continue
+			}
+			name, ok := renames[method.Func.Pointer()]
+			if !ok {
+				name = String.ToSnakeCase(method.Name)
+			}
+			if !yield(name, method) {
+				return
+			}
+		}
+	}
+}
+
+// exportsMethod reports whether the given method of the class is registered as a method of the
+// class, rather than being an override of an engine method or one of the class helpers.
+func exportsMethod(rtype reflect.Type, method reflect.Method) bool {
+	if !method.IsExported() || method.Type.NumIn() < 1 {
+		return false
+	}
+	if strings.HasPrefix(method.Name, "As") || method.Name == "Super" || method.Name == "UnsafePointer" || method.Name == "OnRegister" {
+		return false
+	}
+	parent, ok := rtype.FieldByName("Class")
+	if !ok {
+		panic(fmt.Sprintf("gdextension: %v does not have an embedded Class field", rtype))
+	}
+	if _, ok := reflect.PointerTo(parent.Type).MethodByName(method.Name); ok {
+		return false
+	}
+	return true
+}
+
 func registerStaticMethod(class gd.StringName, name string, fn reflect.Value) {
 	ftype := fn.Type()
 	var arguments = make([]gd.PropertyInfo, 0, ftype.NumIn())
@@ -115,11 +134,44 @@ func registerStaticMethod(class gd.StringName, name string, fn reflect.Value) {
 	})
 }
 
+// registerStaticValue registers the given integer as a constant of the class, see [checkStaticValue].
+func registerStaticValue(class gd.StringName, name string, value reflect.Value) {
+	if err := checkStaticValue(name, value); err != nil {
+		panic("gdextension.RegisterClass: " + err.Error())
+	}
+	var integer int64
+	if value.CanInt() {
+		integer = value.Int()
+	} else {
+		integer = int64(value.Uint())
+	}
+	gd.Global.ClassDB.RegisterClassIntegerConstant(gd.Global.ExtensionToken,
+		class, gd.NewStringName(""), gd.NewStringName(name), integer, false)
+}
+
+// checkStaticValue returns an error unless the value is an integer, as the engine only supports
+// integer constants on extension classes (and no static properties), rather than registering
+// any other value as something that the editor shows differently.
+func checkStaticValue(name string, value reflect.Value) error {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return nil
+	case reflect.Pointer:
+		return fmt.Errorf("cannot register %v as a static property, as extension classes cannot have static properties (register static methods that get and set it instead)", name)
+	default:
+		return fmt.Errorf("cannot register %v as a %v constant, as extension classes only support integer constants (register a static method that returns it instead)", name, value.Type())
+	}
+}
+
 func variantCallStatic(fn reflect.Value) func(instance any, v ...gd.Variant) (gd.Variant, error) {
 	return func(instance any, v ...gd.Variant) (result gd.Variant, err error) {
-		var args = make([]reflect.Value, len(v))
-		for i := 0; i < fn.Type().NumIn()-1; i++ {
-			args[i], err = gd.ConvertToDesiredGoType(v[i], fn.Type().In(i+1))
+		if err := checkArgumentCount(len(v), fn.Type().NumIn()); err != nil { // unlike methods, there is no receiver.
+			return gd.Variant{}, err
+		}
+		var args = make([]reflect.Value, fn.Type().NumIn())
+		for i := range args {
+			args[i], err = gd.ConvertToDesiredGoType(v[i], fn.Type().In(i))
 			if err != nil {
 				EngineClass.Raise(err)
 				return gd.Variant{}, err
@@ -133,10 +185,25 @@ func variantCallStatic(fn reflect.Value) func(instance any, v ...gd.Variant) (gd
 	}
 }
 
+// checkArgumentCount returns a call error for the engine, when a function that takes
+// expected arguments is called with the given number of arguments.
+func checkArgumentCount(given, expected int) error {
+	switch {
+	case given < expected:
+		return &gd.CallError{ErrorType: gd.ErrTooFewArguments, Expected: int32(expected)}
+	case given > expected:
+		return &gd.CallError{ErrorType: gd.ErrTooManyArguments, Expected: int32(expected)}
+	}
+	return nil
+}
+
 func variantCall(method reflect.Method) func(instance any, v ...gd.Variant) (gd.Variant, error) {
 	return func(instance any, v ...gd.Variant) (result gd.Variant, err error) {
-		var args = make([]reflect.Value, len(v))
-		for i := 0; i < method.Type.NumIn()-1; i++ {
+		if err := checkArgumentCount(len(v), method.Type.NumIn()-1); err != nil {
+			return gd.Variant{}, err
+		}
+		var args = make([]reflect.Value, method.Type.NumIn()-1)
+		for i := range args {
 			args[i], err = gd.ConvertToDesiredGoType(v[i], method.Type.In(i+1))
 			if err != nil {
 				EngineClass.Raise(err)
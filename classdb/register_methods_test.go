@@ -0,0 +1,45 @@
+package classdb
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	gd "graphics.gd/internal"
+	"graphics.gd/variant/Vector2"
+)
+
+func TestVariantCallStaticArgumentCount(t *testing.T) {
+	call := variantCallStatic(reflect.ValueOf(func(a, b int) int { return a + b }))
+	for _, tc := range []struct {
+		args []gd.Variant
+		want gd.CallErrorType
+	}{
+		{make([]gd.Variant, 1), gd.ErrTooFewArguments},
+		{make([]gd.Variant, 3), gd.ErrTooManyArguments},
+	} {
+		var callErr *gd.CallError
+		if _, err := call(nil, tc.args...); !errors.As(err, &callErr) || callErr.ErrorType != tc.want || callErr.Expected != 2 {
+			t.Errorf("calling with %d arguments: expected %v, got %v", len(tc.args), tc.want, err)
+		}
+	}
+}
+
+func TestCheckStaticValue(t *testing.T) {
+	score := 10
+	for _, tc := range []struct {
+		name  string
+		value any
+		valid bool
+	}{
+		{"MAX_LIVES", 3, true},
+		{"MASK", uint8(0xff), true},
+		{"GREETING", "hello", false},
+		{"SPAWN", Vector2.New(10, 20), false},
+		{"score", &score, false},
+	} {
+		if err := checkStaticValue(tc.name, reflect.ValueOf(tc.value)); (err == nil) != tc.valid {
+			t.Errorf("%v: expected valid=%v, got %v", tc.name, tc.valid, err)
+		}
+	}
+}
@@ -1,15 +1,21 @@
 package classdb
 
 import (
+	"errors"
 	"fmt"
+	"iter"
 	"reflect"
+	"strings"
 
 	EngineClass "graphics.gd/classdb/Engine"
 	NodeClass "graphics.gd/classdb/Node"
 	ResourceClass "graphics.gd/classdb/Resource"
 	gd "graphics.gd/internal"
+	"graphics.gd/internal/gdclass"
 	"graphics.gd/internal/pointers"
+	"graphics.gd/variant"
 	"graphics.gd/variant/Array"
+	"graphics.gd/variant/Dictionary"
 	"graphics.gd/variant/Enum"
 	"graphics.gd/variant/Object"
 	"graphics.gd/variant/Signal"
@@ -22,10 +28,41 @@ func propertyOf(class gd.StringName, field reflect.StructField) (gd.PropertyInfo
 	if ok {
 		name = tag
 	}
+	info, err := describeProperty(field, registerEnumsFor(class, field.Type))
+	if errors.Is(err, errUnsupported) {
+		return gd.PropertyInfo{}, false
+	}
+	if err != nil {
+		panic(fmt.Sprintf("gdextension.RegisterClass: %v.%v has an invalid tag: %v", class, field.Name, err))
+	}
+	return gd.PropertyInfo{
+		Type:       info.Type,
+		Name:       gd.NewStringName(name),
+		ClassName:  gd.NewStringName(info.ClassName),
+		Hint:       int64(info.Hint),
+		HintString: gd.NewString(info.HintString),
+		Usage:      int64(info.Usage),
+	}, true
+}
+
+// property describes the engine type of a field.
+type property struct {
+	Type       gd.VariantType
+	ClassName  string
+	Hint       PropertyHint
+	HintString string
+	Usage      PropertyUsageFlags
+}
+
+// errUnsupported is returned by [describeProperty] for types that have no engine equivalent.
+var errUnsupported = errors.New("unsupported type")
+
+// describeProperty returns the engine type of the given field, enum should be the values of
+// the field's type, if it is an enum. Any error that isn't [errUnsupported] is an invalid tag.
+func describeProperty(field reflect.StructField, enum iter.Seq2[string, int]) (property, error) {
 	var vtype gd.VariantType
 	var hint PropertyHint
 	var hintString = nameOf(field.Type)
-	var enum = registerEnumsFor(class, field.Type)
 	var className = nameOf(field.Type)
 	if instance, ok := field.Type.MethodByName("Instance"); ok && instance.Type.NumOut() == 2 && field.Type.Name() == "ID" {
 		vtype = gd.TypeObject
@@ -37,6 +74,9 @@ func propertyOf(class gd.StringName, field reflect.StructField) (gd.PropertyInfo
 		case enum != nil:
 			vtype = gd.TypeInt
 			hint |= PropertyHintEnum
+			if field.Type.Implements(reflect.TypeFor[Enum.Bitfield]()) {
+				hint = PropertyHintFlags
+			}
 			hintString = ""
 			var first = true
 			for name, value := range enum {
@@ -51,32 +91,49 @@ func propertyOf(class gd.StringName, field reflect.StructField) (gd.PropertyInfo
 			hint |= PropertyHintResourceType
 			hintString = nameOf(field.Type.Elem())
 		default:
+			var ok bool
 			vtype, ok = gd.VariantTypeOf(field.Type)
 			if !ok {
-				return gd.PropertyInfo{}, false
+				return property{}, fmt.Errorf("%w %v", errUnsupported, field.Type)
 			}
 			if vtype == gd.TypeArray && (field.Type.Kind() == reflect.Array || field.Type.Kind() == reflect.Slice) {
 				elem := field.Type.Elem()
 				etype, ok := gd.VariantTypeOf(elem)
 				if !ok {
-					return gd.PropertyInfo{}, false
+					return property{}, fmt.Errorf("%w %v (for array elements)", errUnsupported, elem)
 				}
 				if elem.Implements(reflect.TypeFor[ResourceClass.Any]()) {
 					hintString = fmt.Sprintf("%d/%d:%s", gd.TypeObject, PropertyHintResourceType, nameOf(elem)) // MAKE_RESOURCE_TYPE_HINT
 				} else if etype != gd.TypeNil {
 					hint |= PropertyHintArrayType
-					hintString = etype.String()
+					hintString = variant.Type(etype).String()
+				}
+			}
+			if vtype == gd.TypeDictionary {
+				if key, val, ok := gd.DictionaryTypesOf(field.Type); ok {
+					khint, kok := containerHintOf(key)
+					if !kok {
+						return property{}, fmt.Errorf("%w %v (for dictionary keys)", errUnsupported, key)
+					}
+					vhint, vok := containerHintOf(val)
+					if !vok {
+						return property{}, fmt.Errorf("%w %v (for dictionary values)", errUnsupported, val)
+					}
+					if khint != "Variant" || vhint != "Variant" {
+						hint |= PropertyHintDictionaryType
+						hintString = khint + ";" + vhint
+					}
 				}
 			}
 			if vtype == gd.TypeArray && field.Type.Implements(reflect.TypeFor[Array.Interface]()) {
 				elem := reflect.Zero(field.Type).Interface().(Array.Interface).ElemType()
 				etype, ok := gd.VariantTypeOf(elem)
 				if !ok {
-					return gd.PropertyInfo{}, false
+					return property{}, fmt.Errorf("%w %v (for array elements)", errUnsupported, elem)
 				}
 				if etype != gd.TypeNil {
 					hint |= PropertyHintArrayType
-					hintString = etype.String()
+					hintString = variant.Type(etype).String()
 				}
 			}
 		}
@@ -91,18 +148,233 @@ func propertyOf(class gd.StringName, field reflect.StructField) (gd.PropertyInfo
 	if vtype == gd.TypeNil {
 		usage |= PropertyUsageNilIsVariant
 	}
-	if rangeHint, ok := field.Tag.Lookup("range"); ok {
-		hint |= PropertyHintRange
-		hintString = rangeHint
+	hint, hintString, usage, err := propertyTags(field, vtype, hint, hintString, usage)
+	if err != nil {
+		return property{}, err
 	}
-	return gd.PropertyInfo{
+	return property{
 		Type:       vtype,
-		Name:       gd.NewStringName(name),
-		ClassName:  gd.NewStringName(className),
-		Hint:       int64(hint),
-		HintString: gd.NewString(hintString),
-		Usage:      int64(usage),
-	}, true
+		ClassName:  className,
+		Hint:       hint,
+		HintString: hintString,
+		Usage:      usage,
+	}, nil
+}
+
+// containerHintOf returns the hint string that describes the given element type of an [Array]
+// or key/value type of a [Dictionary], "Variant" is returned for untyped elements.
+func containerHintOf(elem reflect.Type) (string, bool) {
+	if elem.Kind() == reflect.Interface {
+		return "Variant", true
+	}
+	etype, ok := gd.VariantTypeOf(elem)
+	if !ok {
+		return "", false
+	}
+	switch {
+	case etype == gd.TypeNil:
+		return "Variant", true
+	case elem.Implements(reflect.TypeFor[ResourceClass.Any]()):
+		return fmt.Sprintf("%d/%d:%s", gd.TypeObject, PropertyHintResourceType, nameOf(elem)), true // MAKE_RESOURCE_TYPE_HINT
+	case elem.Implements(reflect.TypeOf([0]interface{ AsNode() NodeClass.Instance }{}).Elem()):
+		return fmt.Sprintf("%d/%d:%s", gd.TypeObject, PropertyHintNodeType, nameOf(elem)), true
+	case etype == gd.TypeObject:
+		return nameOf(elem), true
+	default:
+		return variant.Type(etype).String(), true
+	}
+}
+
+// isPropertyGroup reports whether the given field type is a plain Go struct, such that
+// its fields should be registered as a group of properties, rather than as a single
+// [gd.TypeDictionary] property.
+func isPropertyGroup(rtype reflect.Type) bool {
+	if rtype.Kind() != reflect.Struct {
+		return false
+	}
+	if rtype.Implements(reflect.TypeFor[gd.IsClass]()) || reflect.PointerTo(rtype).Implements(reflect.TypeFor[gd.IsClass]()) ||
+		rtype.Implements(reflect.TypeFor[Enum.Any]()) ||
+		rtype.Implements(reflect.TypeFor[Array.Interface]()) ||
+		rtype.Implements(reflect.TypeFor[Dictionary.Interface]()) ||
+		reflect.PointerTo(rtype).Implements(reflect.TypeFor[Signal.Pointer]()) ||
+		rtype == reflect.TypeFor[Dictionary.Any]() {
+		return false
+	}
+	if vtype, ok := gd.VariantTypeOf(rtype); !ok || vtype != gd.TypeDictionary {
+		return false
+	}
+	for _, field := range reflect.VisibleFields(rtype) {
+		if field.IsExported() && !field.Anonymous {
+			return true
+		}
+	}
+	return false
+}
+
+// classFields walks the fields of a class in the order that they are registered as properties,
+// such that [Register] and [Check] classify each field in the same way. Each callback is optional.
+type classFields struct {
+	class string // name of the class, for errors.
+
+	group    func(depth int, name, prefix string)                 // starts a group (depth 0) or subgroup (depth 1), ends it when name is empty.
+	category func(name string)                                    // starts a category.
+	property func(field reflect.StructField, goName, name string) // a field to register as a property.
+	signal   func(field reflect.StructField, goName, name string) // a signal field, only registered at the top-level, see [classSignals].
+	fail     func(err error)                                      // an invalid field, which is still walked if fail returns.
+}
+
+// walk the fields of rtype, prefixed by the Go path and property path of the group they are nested within.
+func (fields classFields) walk(rtype reflect.Type, goPrefix, prefix string, depth int) {
+	group := func(depth int, name, prefix string) {
+		if fields.group != nil {
+			fields.group(depth, name, prefix)
+		}
+	}
+	for i := range rtype.NumField() {
+		field := rtype.Field(i)
+		if !field.IsExported() || field.Name == "Object" {
+			continue
+		}
+		if field.Anonymous {
+			switch {
+			case isPropertyGroup(field.Type):
+				group(depth, groupNameOf(field), prefix)
+				fields.walk(field.Type, goPrefix, prefix, depth+1)
+				group(depth, "", "")
+			case field.Type.Kind() == reflect.Struct:
+				fields.walk(field.Type, goPrefix, prefix, depth)
+			}
+			continue
+		}
+		if _, ok := field.Type.MethodByName("AsNode"); ok {
+			continue // child nodes.
+		}
+		name := String.ToSnakeCase(field.Name)
+		if tag := field.Tag.Get("gd"); tag != "" {
+			name = tag
+		}
+		if field.Type.Kind() == reflect.Chan || reflect.PointerTo(field.Type).Implements(reflect.TypeFor[Signal.Pointer]()) {
+			if fields.signal != nil {
+				fields.signal(field, goPrefix+field.Name, prefix+name)
+			}
+			continue
+		}
+		if category, ok := field.Tag.Lookup("category"); ok && fields.category != nil {
+			fields.category(category)
+		}
+		if isPropertyGroup(field.Type) {
+			group(depth, groupNameOf(field), prefix+name+"/")
+			fields.walk(field.Type, goPrefix+field.Name+".", prefix+name+"/", depth+1)
+			group(depth, "", "")
+			continue
+		}
+		groups, err := fieldGroups(field, depth)
+		if err != nil && fields.fail != nil {
+			fields.fail(fmt.Errorf("%v.%v%v %w", fields.class, goPrefix, field.Name, err))
+		}
+		for _, start := range groups {
+			group(start.level, start.name, prefix)
+		}
+		if fields.property != nil {
+			fields.property(field, goPrefix+field.Name, prefix+name)
+		}
+	}
+}
+
+// fieldGroup is a group (level 0) or subgroup (level 1) of properties in the inspector.
+type fieldGroup struct {
+	level int
+	name  string
+}
+
+// fieldGroups returns the groups started by the 'group' and 'subgroup' tags of a field, that is
+// nested within the given number of struct groups, which the tags are relative to.
+func fieldGroups(field reflect.StructField, depth int) ([]fieldGroup, error) {
+	var groups []fieldGroup
+	for level, tag := range []string{"group", "subgroup"} {
+		name, ok := field.Tag.Lookup(tag)
+		if !ok {
+			continue
+		}
+		if depth+level > 1 {
+			return nil, fmt.Errorf("has a %s tag, but it is nested too deeply to start a group or subgroup", tag)
+		}
+		groups = append(groups, fieldGroup{depth + level, name})
+	}
+	return groups, nil
+}
+
+// groupNameOf returns the name that the inspector should display for
+// the given group field.
+func groupNameOf(field reflect.StructField) string {
+	if name, ok := field.Tag.Lookup("group"); ok {
+		return name
+	}
+	if field.Anonymous {
+		return field.Type.Name()
+	}
+	return field.Name
+}
+
+// lookupProperty returns the (possibly nested) field that corresponds to the
+// given property name. Nested fields are addressed by their 'group/field' path.
+func lookupProperty(rtype reflect.Type, name string) (reflect.StructField, bool) {
+	var (
+		index []int
+		field reflect.StructField
+	)
+	for segment := range strings.SplitSeq(name, "/") {
+		if rtype.Kind() != reflect.Struct {
+			return reflect.StructField{}, false
+		}
+		var found bool
+		if field, found = rtype.FieldByName(segment); !found || !isProperty(rtype, field) {
+			field, found = rtype.FieldByName(String.ToPascalCase(segment))
+			found = found && isProperty(rtype, field)
+		}
+		if !found {
+			for _, rfield := range reflect.VisibleFields(rtype) {
+				if !isProperty(rtype, rfield) {
+					continue
+				}
+				tag, hasTag := rfield.Tag.Lookup("gd")
+				if hasTag && tag == segment {
+					field, found = rfield, true
+					break
+				}
+				if !hasTag && String.ToSnakeCase(rfield.Name) == segment {
+					field, found = rfield, true
+					break
+				}
+			}
+		}
+		if !found {
+			return reflect.StructField{}, false
+		}
+		index = append(index, field.Index...)
+		rtype = field.Type
+	}
+	field.Index = index
+	return field, true
+}
+
+// isProperty reports whether the field of rtype could have been registered as a property,
+// such that it is exported, along with any embedded fields that it is promoted through.
+func isProperty(rtype reflect.Type, field reflect.StructField) bool {
+	if !field.IsExported() || field.Anonymous {
+		return false
+	}
+	for _, i := range field.Index[:len(field.Index)-1] {
+		embedded := rtype.Field(i)
+		if !embedded.IsExported() {
+			return false
+		}
+		rtype = embedded.Type
+		if rtype.Kind() == reflect.Pointer {
+			rtype = rtype.Elem()
+		}
+	}
+	return true
 }
 
 // Set needs to reference++ any resources that are sucessfully set.
@@ -122,26 +394,11 @@ func (instance *instanceImplementation) Set(name gd.StringName, value gd.Variant
 		return ok
 	}
 	rvalue := reflect.ValueOf(instance.Value).Elem()
-	field := rvalue.FieldByName(sname)
-	if !field.IsValid() {
-		for _, rfield := range reflect.VisibleFields(rvalue.Type()) {
-			if !rfield.IsExported() {
-				continue
-			}
-			tag, hasTag := rfield.Tag.Lookup("gd")
-			if hasTag && !rfield.Anonymous && tag == sname {
-				field = rvalue.FieldByIndex(rfield.Index)
-				break
-			}
-			if !hasTag && String.ToSnakeCase(rfield.Name) == sname {
-				field = rvalue.FieldByIndex(rfield.Index)
-				break
-			}
-		}
-		if !field.IsValid() {
-			return false
-		}
+	rfield, ok := lookupProperty(rvalue.Type(), sname)
+	if !ok {
+		return false
 	}
+	field := rvalue.FieldByIndex(rfield.Index)
 	if !field.CanSet() {
 		return false
 	}
@@ -210,28 +467,18 @@ func (instance *instanceImplementation) Get(name gd.StringName) (gd.Variant, boo
 	}
 	sname := name.String()
 	rvalue := reflect.ValueOf(instance.Value).Elem()
-	field := rvalue.FieldByName(String.ToPascalCase(sname))
-	if !field.IsValid() {
-		for _, rfield := range reflect.VisibleFields(rvalue.Type()) {
-			if !rfield.IsExported() {
-				continue
-			}
-			tag, hasTag := rfield.Tag.Lookup("gd")
-			if hasTag && !rfield.Anonymous && tag == sname {
-				field = rvalue.FieldByIndex(rfield.Index)
-				break
-			}
-			if !hasTag && String.ToSnakeCase(rfield.Name) == sname {
-				field = rvalue.FieldByIndex(rfield.Index)
-				break
+	rfield, ok := lookupProperty(rvalue.Type(), sname)
+	if !ok {
+		if class, ok := gdclass.Registered.Load(rvalue.Type()); ok {
+			if method, ok := class.(*classImplementation).Buttons[sname]; ok { // tool button for a method.
+				return gd.NewVariant(reflect.ValueOf(instance.Value).MethodByName(method).Interface()), true
 			}
 		}
-		if !field.IsValid() {
-			return gd.Variant{}, false
-		}
-		if field.Type().Kind() == reflect.Chan || reflect.PointerTo(field.Type()).Implements(reflect.TypeFor[Signal.Pointer]()) {
-			return gd.Variant{}, false
-		}
+		return gd.Variant{}, false
+	}
+	field := rvalue.FieldByIndex(rfield.Index)
+	if field.Type().Kind() == reflect.Chan || reflect.PointerTo(field.Type()).Implements(reflect.TypeFor[Signal.Pointer]()) {
+		return gd.Variant{}, false
 	}
 	if field.Type().Implements(reflect.TypeFor[interface{ superType() reflect.Type }]()) {
 		if field.IsZero() {
@@ -241,7 +488,7 @@ func (instance *instanceImplementation) Get(name gd.StringName) (gd.Variant, boo
 		vary := gd.NewVariant(obj)
 		return vary, true
 	}
-	return gd.NewVariant(field.Interface()), true
+	return propertyVariant(field), true
 }
 
 func (instance *instanceImplementation) GetPropertyList() []gd.PropertyInfo {
@@ -272,18 +519,7 @@ func (instance *instanceImplementation) PropertyCanRevert(name gd.StringName) bo
 	}); ok {
 		return bool(impl.PropertyCanRevert(name.String()))
 	}
-	sname := name.String()
-	rtype := reflect.TypeOf(instance.Value).Elem()
-	field, ok := rtype.FieldByName(sname)
-	if !ok {
-		for _, rfield := range reflect.VisibleFields(rtype) {
-			if rfield.Tag.Get("gd") == sname {
-				field = rtype.FieldByIndex(rfield.Index)
-				ok = true
-				break
-			}
-		}
-	}
+	field, ok := lookupProperty(reflect.TypeOf(instance.Value).Elem(), name.String())
 	if !ok {
 		return false
 	}
@@ -297,29 +533,35 @@ func (instance *instanceImplementation) PropertyGetRevert(name gd.StringName) (g
 		val, ok := impl.PropertyGetRevert(name.String())
 		return gd.NewVariant(val), ok
 	}
-	sname := name.String()
-	rtype := reflect.TypeOf(instance.Value).Elem()
-	field, ok := rtype.FieldByName(sname)
+	field, ok := lookupProperty(reflect.TypeOf(instance.Value).Elem(), name.String())
 	if !ok {
-		for _, rfield := range reflect.VisibleFields(rtype) {
-			if rfield.Tag.Get("gd") == sname {
-				field = rtype.FieldByIndex(rfield.Index)
-				ok = true
-				break
-			}
-		}
+		return gd.Variant{}, false
 	}
+	if _, ok := field.Tag.Lookup("default"); !ok {
+		return propertyVariant(reflect.Zero(field.Type)), true
+	}
+	class, ok := gdclass.Registered.Load(reflect.TypeOf(instance.Value).Elem())
 	if !ok {
 		return gd.Variant{}, false
 	}
-	var value = reflect.New(field.Type)
-	if tag := field.Tag.Get("default"); tag != "" {
-		_, err := fmt.Sscanf(tag, "%v", value.Interface())
-		if err != nil {
-			return gd.Variant{}, false
-		}
+	tag, ok := defaultOf(class.(*classImplementation).Defaults, field.Index)
+	if !ok {
+		return gd.Variant{}, false
+	}
+	value, err := tag.decodeField(field.Type)
+	if err != nil {
+		return gd.Variant{}, false
+	}
+	return propertyVariant(value), true
+}
+
+// propertyVariant converts the value of an exported property into a variant, Go maps and
+// [Dictionary.Map] values are converted into typed dictionaries to match the property's hint.
+func propertyVariant(value reflect.Value) gd.Variant {
+	if _, _, ok := gd.DictionaryTypesOf(value.Type()); ok {
+		return gd.NewVariant(gd.NewTypedDictionary(value))
 	}
-	return gd.NewVariant(value.Elem().Interface()), true
+	return gd.NewVariant(value.Interface())
 }
 
 func (instance *instanceImplementation) ValidateProperty(info *gd.PropertyInfo) bool {
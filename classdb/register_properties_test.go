@@ -0,0 +1,78 @@
+package classdb
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestFieldGroups(t *testing.T) {
+	field := func(tag reflect.StructTag) reflect.StructField {
+		return reflect.StructField{Name: "Field", Type: reflect.TypeFor[int](), Tag: tag}
+	}
+	for _, tc := range []struct {
+		tag    reflect.StructTag
+		depth  int
+		expect []fieldGroup
+		fails  bool
+	}{
+		{tag: `group:"A"`, depth: 0, expect: []fieldGroup{{0, "A"}}},
+		{tag: `subgroup:"B"`, depth: 0, expect: []fieldGroup{{1, "B"}}},
+		{tag: `group:"A" subgroup:"B"`, depth: 0, expect: []fieldGroup{{0, "A"}, {1, "B"}}},
+		{tag: `group:"A"`, depth: 1, expect: []fieldGroup{{1, "A"}}}, // within a struct group.
+		{tag: `subgroup:"B"`, depth: 1, fails: true},
+		{tag: `group:"A"`, depth: 2, fails: true},
+		{tag: ``, depth: 3},
+	} {
+		groups, err := fieldGroups(field(tc.tag), tc.depth)
+		if (err != nil) != tc.fails {
+			t.Fatalf("%s at depth %d: expected failure=%v, got %v", tc.tag, tc.depth, tc.fails, err)
+		}
+		if !slices.Equal(groups, tc.expect) {
+			t.Fatalf("%s at depth %d: expected %v, got %v", tc.tag, tc.depth, tc.expect, groups)
+		}
+	}
+}
+
+func TestClassFields(t *testing.T) {
+	type Stats struct {
+		Health int
+		Armor  int `group:"Defence"`
+	}
+	type Player struct {
+		Name    string `category:"Player"`
+		Stats   Stats
+		Hit     chan<- int
+		private int
+		Speed   float64 `gd:"max_speed"`
+	}
+	var events []string
+	classFields{
+		class: "Player",
+		group: func(depth int, name, prefix string) {
+			events = append(events, fmt.Sprint("group ", depth, " ", name, " ", prefix))
+		},
+		category: func(name string) { events = append(events, "category "+name) },
+		property: func(field reflect.StructField, goName, name string) {
+			events = append(events, "property "+goName+" "+name)
+		},
+		signal: func(field reflect.StructField, goName, name string) {
+			events = append(events, "signal "+goName+" "+name)
+		},
+		fail: func(err error) { t.Fatal(err) },
+	}.walk(reflect.TypeFor[Player](), "", "", 0)
+	if expect := []string{
+		"category Player",
+		"property Name name",
+		"group 0 Stats stats/",
+		"property Stats.Health stats/health",
+		"group 1 Defence stats/",
+		"property Stats.Armor stats/armor",
+		"group 0  ",
+		"signal Hit hit",
+		"property Speed max_speed",
+	}; !slices.Equal(events, expect) {
+		t.Fatalf("expected\n%q\ngot\n%q", expect, events)
+	}
+}
@@ -0,0 +1,124 @@
+package classdb
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+
+	MultiplayerAPIClass "graphics.gd/classdb/MultiplayerAPI"
+	MultiplayerPeerClass "graphics.gd/classdb/MultiplayerPeer"
+	NodeClass "graphics.gd/classdb/Node"
+	"graphics.gd/variant/Object"
+	"graphics.gd/variant/String"
+
+	gd "graphics.gd/internal"
+)
+
+// RPC configures a method of a Node class so that it can be called remotely by the [MultiplayerAPIClass.Instance]
+// (equivalent to GDScript's @rpc annotation). Pass a map[string]RPC keyed by method name to [Register], the
+// method can then be called type-safely with [Remote], or with the Node's Rpc and RpcId methods.
+//
+//	classdb.Register[Player](map[string]classdb.RPC{
+//		"Shoot": {Mode: MultiplayerAPI.RpcModeAnyPeer, Transfer: MultiplayerPeer.TransferModeReliable, CallLocal: true},
+//	})
+type RPC struct {
+	Mode      MultiplayerAPIClass.RPCMode       // [MultiplayerAPIClass.RpcModeDisabled] means the method is not an RPC.
+	Transfer  MultiplayerPeerClass.TransferMode // defaults to [MultiplayerPeerClass.TransferModeUnreliable]
+	Channel   int                               // transfer channel
+	CallLocal bool                              // if true, the method is also called locally.
+}
+
+// config returns the rpc_config dictionary for the RPC.
+func (rpc RPC) config() map[string]any {
+	return map[string]any{
+		"rpc_mode":      int(rpc.Mode),
+		"transfer_mode": int(rpc.Transfer),
+		"call_local":    rpc.CallLocal,
+		"channel":       rpc.Channel,
+	}
+}
+
+// rpcNames maps the method pointer of each registered RPC to its method name.
+var rpcNames sync.Map
+
+// registerRPCs resolves the method names of the given RPC configurations, using either the Go name or
+// the snake_case name of each method, along with the resolved name for each method pointer. Methods
+// with the [MultiplayerAPIClass.RpcModeDisabled] mode are not RPCs and are left out.
+func registerRPCs(class, super reflect.Type, rpcs map[string]RPC, renames map[uintptr]string) (map[string]RPC, map[uintptr]string) {
+	if len(rpcs) == 0 {
+		return nil, nil
+	}
+	var resolved = make(map[string]RPC, len(rpcs))
+	var names = make(map[uintptr]string, len(rpcs))
+	rtype := reflect.PointerTo(class)
+	for name, rpc := range rpcs {
+		method, ok := rtype.MethodByName(name)
+		if !ok {
+			method, ok = rtype.MethodByName(String.ToPascalCase(name))
+		}
+		if !ok {
+			panic(fmt.Sprintf("gdextension.RegisterClass: %v has no %v method to configure as an RPC", class.Name(), name))
+		}
+		if _, ok := super.MethodByName("AsNode"); !ok {
+			panic(fmt.Sprintf("gdextension.RegisterClass: %v must extend Node in order to configure %v as an RPC", class.Name(), name))
+		}
+		if rpc.Channel < 0 {
+			panic(fmt.Sprintf("gdextension.RegisterClass: %v.%v has an invalid RPC channel %d", class.Name(), name, rpc.Channel))
+		}
+		if rpc.Mode == MultiplayerAPIClass.RpcModeDisabled {
+			continue
+		}
+		pc := method.Func.Pointer()
+		rename, ok := renames[pc]
+		if !ok {
+			rename = String.ToSnakeCase(method.Name)
+		}
+		resolved[rename] = rpc
+		names[pc] = rename
+	}
+	return resolved, names
+}
+
+// remoteClass is implemented by the pointer type of each registered class.
+type remoteClass interface {
+	AsObject() [1]gd.Object
+}
+
+// remote sends the RPC registered for the given method expression to the peer.
+func remote(class remoteClass, peer int, method any, args ...any) error {
+	pc := reflect.ValueOf(method).Pointer()
+	name, ok := rpcNames.Load(pc)
+	if !ok {
+		return fmt.Errorf("gd: %v is not a registered RPC", runtime.FuncForPC(pc).Name())
+	}
+	node, ok := As[NodeClass.Instance](Object.Instance(class.AsObject()))
+	if !ok {
+		return fmt.Errorf("gd: %v is not a Node", runtime.FuncForPC(pc).Name())
+	}
+	return node.RpcId(peer, name.(string), args...)
+}
+
+// Remote calls the given method expression of a registered class (ie. (*Player).Shoot) on the node
+// as an RPC, on the given peer, or on every peer when peer is zero. The method must have been configured
+// with an [RPC] when the class was registered.
+//
+//	classdb.Remote(player, 0, (*Player).Jump)
+func Remote[T remoteClass](node T, peer int, method func(T)) error {
+	return remote(node, peer, method)
+}
+
+// Remote1 is like [Remote] but for methods that accept one argument.
+func Remote1[T remoteClass, A any](node T, peer int, method func(T, A), a A) error {
+	return remote(node, peer, method, a)
+}
+
+// Remote2 is like [Remote] but for methods that accept two arguments.
+func Remote2[T remoteClass, A, B any](node T, peer int, method func(T, A, B), a A, b B) error {
+	return remote(node, peer, method, a, b)
+}
+
+// Remote3 is like [Remote] but for methods that accept three arguments.
+func Remote3[T remoteClass, A, B, C any](node T, peer int, method func(T, A, B, C), a A, b B, c C) error {
+	return remote(node, peer, method, a, b, c)
+}
@@ -2,6 +2,7 @@ package classdb
 
 import (
 	"fmt"
+	"iter"
 	"reflect"
 	"strings"
 
@@ -15,83 +16,99 @@ import (
 // emittable by the class, when the class is instantiated, the signal field needs to injected into the field
 // so that it can be used and emitted.
 func registerSignals(class gd.StringName, rtype reflect.Type) {
-	for _, field := range reflect.VisibleFields(rtype) {
-		name := String.ToSnakeCase(field.Name)
-		if !field.IsExported() {
+	for signal, err := range classSignals(rtype) {
+		if err != nil {
+			panic("gdextension.RegisterClass: " + err.Error())
+		}
+		if signal.skipped != "" {
 			continue
 		}
-		if tag := field.Tag.Get("gd"); tag != "" {
-			name = tag
+		var args []gd.PropertyInfo
+		for i, arg := range signal.args {
+			vtype, ok := gd.VariantTypeOf(arg)
+			if ok {
+				args = append(args, gd.PropertyInfo{
+					Type:      vtype,
+					Name:      gd.NewStringName(signal.argNames[i]),
+					ClassName: gd.NewStringName(nameOf(arg)),
+				})
+			}
 		}
-		name = strings.TrimSuffix(name, ")")
-		name, args, _ := strings.Cut(name, "(")
-		argNames := strings.Split(args, ",")
-		if reflect.PointerTo(field.Type).Implements(reflect.TypeFor[Signal.Pointer]()) {
-			var signalName = gd.NewStringName(name)
-			var emit, ok = field.Type.MethodByName("Emit")
-			if !ok {
-				panic("gdextension.RegisterClass: Signal[T] Emit method not found")
+		gd.Global.ClassDB.RegisterClassSignal(gd.Global.ExtensionToken, class, gd.NewStringName(signal.name), args)
+	}
+}
+
+// classSignal is a signal field of a class, see [classSignals].
+type classSignal struct {
+	field    reflect.StructField
+	name     string
+	args     []reflect.Type // types of the signal's arguments.
+	argNames []string       // names of the signal's arguments, from the 'gd' tag, ie. `gd:"hit(damage)"`
+	skipped  string         // reason why the field is not registered as a signal (empty if it is).
+}
+
+// classSignals returns the signal fields of the class, such that [Register] and [Check] agree on
+// which signals are registered.
+func classSignals(rtype reflect.Type) iter.Seq2[classSignal, error] {
+	return func(yield func(classSignal, error) bool) {
+		for _, field := range reflect.VisibleFields(rtype) {
+			if !field.IsExported() {
+				continue
 			}
-			ftype := emit.Type
-			if ftype.Kind() != reflect.Func {
-				panic("gdextension.RegisterClass: Signal[T] Emit field must be a function")
+			name := String.ToSnakeCase(field.Name)
+			if tag := field.Tag.Get("gd"); tag != "" {
+				name = tag
 			}
-			if ftype.NumOut() != 0 {
-				panic(fmt.Sprintf("gdextension.RegisterClass: %v.%v must not return any values",
-					rtype.Name(), name))
+			name = strings.TrimSuffix(name, ")")
+			name, args, _ := strings.Cut(name, "(")
+			argNames := strings.Split(args, ",")
+			signal := classSignal{field: field, name: name}
+			// argName returns the name of the i'th argument, or the fallback, if it is not named.
+			argName := func(i int, fallback string) string {
+				if i < len(argNames) {
+					return argNames[i]
+				}
+				return fallback
 			}
-			var args []gd.PropertyInfo
-			for i := 1; i < ftype.NumIn(); i++ {
-				vtype, ok := gd.VariantTypeOf(ftype.In(i))
-				if ok {
-					name := fmt.Sprintf("arg%d", i)
-					if i-1 < len(argNames) {
-						name = argNames[i-1]
+			switch {
+			case reflect.PointerTo(field.Type).Implements(reflect.TypeFor[Signal.Pointer]()):
+				emit, ok := field.Type.MethodByName("Emit")
+				if !ok {
+					if !yield(signal, fmt.Errorf("Signal[T] Emit method not found")) {
+						return
 					}
-					args = append(args, gd.PropertyInfo{
-						Type:      vtype,
-						Name:      gd.NewStringName(name),
-						ClassName: gd.NewStringName(nameOf(ftype.In(i))),
-					})
+					continue
 				}
-			}
-			gd.Global.ClassDB.RegisterClassSignal(gd.Global.ExtensionToken, class, signalName, args)
-		}
-		if field.Type.Kind() == reflect.Chan && field.Type.ChanDir() == reflect.SendDir {
-			var signalName = gd.NewStringName(name)
-			var etype = field.Type.Elem()
-			var args []gd.PropertyInfo
-			if etype.Kind() == reflect.Func {
-				for i := 0; i < etype.NumOut(); i++ {
-					arg := etype.Out(i)
-					vtype, ok := gd.VariantTypeOf(arg)
-					if ok {
-						name := fmt.Sprintf("arg%d", i)
-						if i < len(argNames) {
-							name = argNames[i]
-						}
-						args = append(args, gd.PropertyInfo{
-							Type:      vtype,
-							Name:      gd.NewStringName(name),
-							ClassName: gd.NewStringName(nameOf(arg)),
-						})
+				if emit.Type.NumOut() != 0 {
+					if !yield(signal, fmt.Errorf("%v.%v must not return any values", rtype.Name(), name)) {
+						return
 					}
+					continue
+				}
+				for i := 1; i < emit.Type.NumIn(); i++ {
+					signal.args = append(signal.args, emit.Type.In(i))
+					signal.argNames = append(signal.argNames, argName(i-1, fmt.Sprintf("arg%d", i)))
 				}
-			} else if !(etype.Kind() == reflect.Struct && etype.NumField() == 0) {
-				vtype, ok := gd.VariantTypeOf(etype)
-				if ok {
-					name := fmt.Sprintf("event")
-					if len(argNames) > 0 {
-						name = argNames[0]
+			case field.Type.Kind() == reflect.Chan && field.Type.ChanDir() == reflect.SendDir:
+				etype := field.Type.Elem()
+				switch {
+				case etype.Kind() == reflect.Func:
+					for i := range etype.NumOut() {
+						signal.args = append(signal.args, etype.Out(i))
+						signal.argNames = append(signal.argNames, argName(i, fmt.Sprintf("arg%d", i)))
 					}
-					args = append(args, gd.PropertyInfo{
-						Type:      vtype,
-						Name:      gd.NewStringName(name),
-						ClassName: gd.NewStringName(nameOf(etype)),
-					})
+				case !(etype.Kind() == reflect.Struct && etype.NumField() == 0):
+					signal.args = append(signal.args, etype)
+					signal.argNames = append(signal.argNames, argName(0, "event"))
 				}
+			case field.Type.Kind() == reflect.Chan:
+				signal.skipped = "not registered, only send-only channels (chan<-) are registered as signals"
+			default:
+				continue
+			}
+			if !yield(signal, nil) {
+				return
 			}
-			gd.Global.ClassDB.RegisterClassSignal(gd.Global.ExtensionToken, class, signalName, args)
 		}
 	}
 }
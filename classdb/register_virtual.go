@@ -0,0 +1,119 @@
+package classdb
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+
+	gd "graphics.gd/internal"
+	"graphics.gd/internal/gdclass"
+	"graphics.gd/variant/String"
+)
+
+// Virtuals can be passed to [Register] in order to declare methods of the class (as method expressions,
+// ie. (*MyClass).OnHit) as virtual, such that they can be overridden by scripts that extend the class.
+// Each virtual method is registered with a leading underscore (ie. _on_hit) and the Go implementation
+// remains available to scripts under its regular name (ie. on_hit). Go code should call virtual methods
+// through [Virtual] so that any script override is respected.
+type Virtuals []any
+
+// Virtual returns the script override of the given method value, if the script attached to the
+// class overrides it, otherwise the method itself is returned. If the override returns a value that
+// cannot be converted to the method's result, an error is reported to the engine and the zero value
+// is returned.
+//
+//	func (e *Enemy) Hit(damage int) {
+//		if classdb.Virtual(e, e.OnHit)(damage) {
+//			e.Die()
+//		}
+//	}
+func Virtual[F any](class Class, method F) F {
+	rvalue := reflect.ValueOf(method)
+	if rvalue.Kind() != reflect.Func {
+		panic(fmt.Sprintf("classdb.Virtual: invalid method type %T (expected method value)", method))
+	}
+	object := gdclass.GetObject(class)
+	if object == ([1]gd.Object{}) {
+		return method
+	}
+	fn := runtime.FuncForPC(rvalue.Pointer())
+	name := gd.NewStringName(virtualNameOf(fn.Name()))
+	if !object[0].HasMethod(name) {
+		return method
+	}
+	rtype := rvalue.Type()
+	return reflect.MakeFunc(rtype, func(args []reflect.Value) []reflect.Value {
+		var array = gd.NewArray()
+		for _, arg := range args {
+			array.PushBack(gd.NewVariant(arg.Interface()))
+		}
+		result := object[0].Callv(name, array)
+		var results = make([]reflect.Value, rtype.NumOut())
+		for i := range results {
+			results[i] = reflect.Zero(rtype.Out(i))
+		}
+		if len(results) > 0 {
+			converted, err := gd.ConvertToDesiredGoType(result, rtype.Out(0))
+			if err != nil {
+				file, line := fn.FileLine(fn.Entry())
+				gd.Global.PrintErrorMessage("classdb.Virtual: invalid result",
+					fmt.Sprintf("the script override of %v returned a %v, which cannot be converted to %v (the zero value is used instead): %v",
+						name, result.Type(), rtype.Out(0), err), fn.Name(), file, int32(line), true)
+			} else {
+				results[0] = converted
+			}
+		}
+		return results
+	}).Interface().(F)
+}
+
+// virtualNameOf returns the name of the virtual method for the given function name,
+// ie. "example.(*Enemy).OnHit-fm" becomes "_on_hit".
+func virtualNameOf(fname string) string {
+	fname = strings.TrimSuffix(fname, "-fm")
+	return "_" + String.ToSnakeCase(fname[strings.LastIndexByte(fname, '.')+1:])
+}
+
+// checkVirtual returns the method expression of a virtual method of the class.
+func checkVirtual(rtype reflect.Type, virtual any) reflect.Value {
+	fn := reflect.ValueOf(virtual)
+	if fn.Kind() != reflect.Func || fn.Type().NumIn() < 1 || fn.Type().In(0) != reflect.PointerTo(rtype) {
+		panic(fmt.Sprintf("gdextension.RegisterClass: invalid virtual %T (expected method expression of *%v)", virtual, rtype.Name()))
+	}
+	return fn
+}
+
+// registerVirtualMethods registers the given method expressions as virtual methods of the class.
+func registerVirtualMethods(class gd.StringName, rtype reflect.Type, virtuals Virtuals) {
+	for _, virtual := range virtuals {
+		fn := checkVirtual(rtype, virtual)
+		if gd.Global.ClassDB.RegisterClassVirtualMethod == nil {
+			continue
+		}
+		ftype := fn.Type()
+		var arguments = make([]gd.PropertyInfo, 0, ftype.NumIn()-1)
+		var metadatas = make([]gd.ClassMethodArgumentMetadata, 0, ftype.NumIn()-1)
+		for i := 1; i < ftype.NumIn(); i++ {
+			vtype, ok := propertyOf(class, reflect.StructField{Name: "arg" + fmt.Sprint(i), Type: ftype.In(i)})
+			if ok {
+				arguments = append(arguments, vtype)
+				metadatas = append(metadatas, 0)
+			}
+		}
+		var returns *gd.PropertyInfo
+		if ftype.NumOut() > 0 {
+			property, ok := propertyOf(class, reflect.StructField{Name: "result", Type: ftype.Out(0)})
+			if ok {
+				returns = &property
+			}
+		}
+		gd.Global.ClassDB.RegisterClassVirtualMethod(gd.Global.ExtensionToken, class, gd.Method{
+			Name:              gd.NewStringName(virtualNameOf(runtime.FuncForPC(fn.Pointer()).Name())),
+			MethodFlags:       gd.MethodFlags(MethodFlagVirtual),
+			Arguments:         arguments,
+			ArgumentsMetadata: metadatas,
+			ReturnValueInfo:   returns,
+		})
+	}
+}
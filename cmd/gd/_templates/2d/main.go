@@ -0,0 +1,98 @@
+// This is a 2D platformer, use the arrow keys to run and the space bar to jump.
+package main
+
+import (
+	"graphics.gd/classdb"
+	"graphics.gd/classdb/Camera2D"
+	"graphics.gd/classdb/CharacterBody2D"
+	"graphics.gd/classdb/CollisionShape2D"
+	"graphics.gd/classdb/ColorRect"
+	"graphics.gd/classdb/Input"
+	"graphics.gd/classdb/Node2D"
+	"graphics.gd/classdb/RectangleShape2D"
+	"graphics.gd/classdb/SceneTree"
+	"graphics.gd/classdb/StaticBody2D"
+	"graphics.gd/startup"
+	"graphics.gd/variant/Color"
+	"graphics.gd/variant/Float"
+	"graphics.gd/variant/Vector2"
+)
+
+// Player can run and jump between platforms.
+type Player struct {
+	CharacterBody2D.Extension[Player]
+
+	Speed     Float.X // pixels per second.
+	JumpSpeed Float.X // pixels per second.
+	Gravity   Float.X // pixels per second, per second.
+}
+
+// NewPlayer returns a player with a collision shape and a sprite.
+func NewPlayer() *Player {
+	player := &Player{Speed: 300, JumpSpeed: 600, Gravity: 1500}
+	size := Vector2.New(32, 48)
+	player.AsNode().AddChild(box(size, Color.RGBA{R: 0.2, G: 0.6, B: 1, A: 1}).AsNode())
+	camera := Camera2D.New()
+	player.AsNode().AddChild(camera.AsNode())
+	return player
+}
+
+func (p *Player) PhysicsProcess(delta Float.X) {
+	body := p.AsCharacterBody2D()
+	body.SetVelocity(p.Move(body.Velocity(), Input.GetAxis("ui_left", "ui_right"),
+		Input.IsActionJustPressed("ui_accept", false), body.IsOnFloor(), delta))
+	body.MoveAndSlide()
+}
+
+// Move returns the velocity of the player after delta seconds, given the direction that
+// the player is running in (between -1 and 1) and whether they want to jump.
+func (p *Player) Move(velocity Vector2.XY, direction Float.X, jump, onFloor bool, delta Float.X) Vector2.XY {
+	velocity.X = direction * p.Speed
+	switch {
+	case onFloor && jump:
+		velocity.Y = -p.JumpSpeed
+	case !onFloor:
+		velocity.Y += p.Gravity * delta
+	}
+	return velocity
+}
+
+// NewLevel returns the level, with a floor and some platforms to jump on.
+func NewLevel() Node2D.Instance {
+	level := Node2D.New()
+	for _, platform := range []struct{ X, Y, W Float.X }{
+		{0, 300, 2000},
+		{-200, 150, 200},
+		{150, 50, 200},
+		{450, -50, 200},
+	} {
+		body := StaticBody2D.New()
+		body.AsNode2D().SetPosition(Vector2.New(platform.X, platform.Y))
+		body.AsNode().AddChild(box(Vector2.New(platform.W, 32), Color.RGBA{R: 0.3, G: 0.8, B: 0.3, A: 1}).AsNode())
+		level.AsNode().AddChild(body.AsNode())
+	}
+	player := NewPlayer()
+	level.AsNode().AddChild(player.AsNode())
+	return level
+}
+
+// box returns a rectangular collision shape of the given size, drawn with the given color.
+func box(size Vector2.XY, color Color.RGBA) CollisionShape2D.Instance {
+	shape := RectangleShape2D.New()
+	shape.SetSize(size)
+	collision := CollisionShape2D.New()
+	collision.SetShape(shape.AsShape2D())
+	rect := ColorRect.New()
+	rect.SetColor(color)
+	rect.AsControl().SetSize(size)
+	rect.AsControl().SetPosition(Vector2.DivX(size, -2))
+	collision.AsNode().AddChild(rect.AsNode())
+	return collision
+}
+
+func main() {
+	classdb.Register[Player](NewPlayer)
+	startup.LoadingScene()
+	SceneTree.Add(NewLevel())
+	startup.Scene()
+}
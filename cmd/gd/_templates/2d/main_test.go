@@ -0,0 +1,38 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"graphics.gd/classdb"
+	"graphics.gd/startup"
+	"graphics.gd/variant/Vector2"
+)
+
+// TestMain runs the tests inside the engine, run them with 'gd test'.
+func TestMain(m *testing.M) {
+	classdb.Register[Player](NewPlayer)
+	startup.LoadingScene()
+	os.Exit(m.Run())
+}
+
+func TestPlayerMove(t *testing.T) {
+	player := NewPlayer()
+	if v := player.Move(Vector2.Zero, 1, false, true, 0.1); v.X != player.Speed || v.Y != 0 {
+		t.Fatalf("expected the player to run right, got %v", v)
+	}
+	if v := player.Move(Vector2.Zero, 0, true, true, 0.1); v.Y != -player.JumpSpeed {
+		t.Fatalf("expected the player to jump, got %v", v)
+	}
+	if v := player.Move(Vector2.Zero, 0, true, false, 0.1); v.Y <= 0 {
+		t.Fatalf("expected the player to fall, got %v", v)
+	}
+}
+
+func TestLevel(t *testing.T) {
+	level := NewLevel()
+	defer level.AsNode().QueueFree()
+	if n := level.AsNode().GetChildCount(); n != 5 {
+		t.Fatalf("expected 4 platforms and a player, got %d nodes", n)
+	}
+}
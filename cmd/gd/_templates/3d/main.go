@@ -0,0 +1,137 @@
+// This is a 3D first-person scene, use the arrow keys to walk, the mouse to look around, the
+// space bar to jump and escape to release the mouse.
+package main
+
+import (
+	"graphics.gd/classdb"
+	"graphics.gd/classdb/BoxMesh"
+	"graphics.gd/classdb/BoxShape3D"
+	"graphics.gd/classdb/Camera3D"
+	"graphics.gd/classdb/CapsuleShape3D"
+	"graphics.gd/classdb/CharacterBody3D"
+	"graphics.gd/classdb/CollisionShape3D"
+	"graphics.gd/classdb/DirectionalLight3D"
+	"graphics.gd/classdb/Input"
+	"graphics.gd/classdb/InputEvent"
+	"graphics.gd/classdb/InputEventMouseMotion"
+	"graphics.gd/classdb/MeshInstance3D"
+	"graphics.gd/classdb/Node3D"
+	"graphics.gd/classdb/SceneTree"
+	"graphics.gd/classdb/StaticBody3D"
+	"graphics.gd/startup"
+	"graphics.gd/variant/Angle"
+	"graphics.gd/variant/Basis"
+	"graphics.gd/variant/Float"
+	"graphics.gd/variant/Object"
+	"graphics.gd/variant/Vector2"
+	"graphics.gd/variant/Vector3"
+)
+
+// Player is a first-person character, that looks around with the mouse.
+type Player struct {
+	CharacterBody3D.Extension[Player]
+
+	Speed       Float.X // meters per second.
+	JumpSpeed   Float.X // meters per second.
+	Gravity     Float.X // meters per second, per second.
+	Sensitivity Float.X // radians per pixel of mouse movement.
+
+	head Camera3D.Instance
+}
+
+// NewPlayer returns a player with a capsule collision shape and a camera at eye level.
+func NewPlayer() *Player {
+	player := &Player{Speed: 5, JumpSpeed: 4.5, Gravity: 9.8, Sensitivity: 0.003}
+	shape := CapsuleShape3D.New()
+	collision := CollisionShape3D.New()
+	collision.SetShape(shape.AsShape3D())
+	collision.AsNode3D().SetPosition(Vector3.New(0, 1, 0))
+	player.AsNode().AddChild(collision.AsNode())
+	player.head = Camera3D.New()
+	player.head.AsNode3D().SetPosition(Vector3.New(0, 1.6, 0))
+	player.AsNode().AddChild(player.head.AsNode())
+	return player
+}
+
+func (p *Player) Ready() {
+	Input.SetMouseMode(Input.MouseModeCaptured)
+}
+
+func (p *Player) UnhandledInput(event InputEvent.Instance) {
+	if motion, ok := Object.As[InputEventMouseMotion.Instance](event); ok && Input.MouseMode() == Input.MouseModeCaptured {
+		p.Look(motion.Relative())
+	}
+	if event.IsActionPressed("ui_cancel") {
+		Input.SetMouseMode(Input.MouseModeVisible)
+	}
+}
+
+// Look turns the player left and right, and tilts the camera up and down, by the given
+// relative mouse movement.
+func (p *Player) Look(relative Vector2.XY) {
+	p.AsNode3D().RotateY(-relative.X * p.Sensitivity)
+	tilt := p.head.AsNode3D().Rotation()
+	limit := Float.X(Angle.Pi / 2) // straight up or down.
+	tilt.X = Float.Clamp(tilt.X-relative.Y*p.Sensitivity, -limit, limit)
+	p.head.AsNode3D().SetRotation(tilt)
+}
+
+func (p *Player) PhysicsProcess(delta Float.X) {
+	body := p.AsCharacterBody3D()
+	body.SetVelocity(p.Move(body.Velocity(), Input.GetVector("ui_left", "ui_right", "ui_up", "ui_down"),
+		Input.IsActionJustPressed("ui_accept", false), body.IsOnFloor(), delta))
+	body.MoveAndSlide()
+}
+
+// Move returns the velocity of the player after delta seconds, given the direction that the
+// player wants to walk in, relative to where they are facing, and whether they want to jump.
+func (p *Player) Move(velocity Vector3.XYZ, direction Vector2.XY, jump, onFloor bool, delta Float.X) Vector3.XYZ {
+	walk := Basis.Transform(Vector3.New(direction.X, 0, direction.Y), p.AsNode3D().Basis())
+	velocity.X = walk.X * p.Speed
+	velocity.Z = walk.Z * p.Speed
+	switch {
+	case onFloor && jump:
+		velocity.Y = p.JumpSpeed
+	case !onFloor:
+		velocity.Y -= p.Gravity * delta
+	}
+	return velocity
+}
+
+// NewLevel returns the level, with a floor, a light and some crates.
+func NewLevel() Node3D.Instance {
+	level := Node3D.New()
+	level.AsNode().AddChild(block(Vector3.New(50, 1, 50), Vector3.New(0, -0.5, 0)).AsNode())
+	for i := range 5 {
+		level.AsNode().AddChild(block(Vector3.New(1, 1, 1), Vector3.New(Float.X(i*2-4), 0.5, -5)).AsNode())
+	}
+	light := DirectionalLight3D.New()
+	light.AsNode3D().SetRotation(Vector3.New(-Angle.Pi/4, Angle.Pi/4, 0))
+	level.AsNode().AddChild(light.AsNode())
+	level.AsNode().AddChild(NewPlayer().AsNode())
+	return level
+}
+
+// block returns a solid box of the given size, at the given position.
+func block(size, position Vector3.XYZ) StaticBody3D.Instance {
+	body := StaticBody3D.New()
+	body.AsNode3D().SetPosition(position)
+	shape := BoxShape3D.New()
+	shape.SetSize(size)
+	collision := CollisionShape3D.New()
+	collision.SetShape(shape.AsShape3D())
+	body.AsNode().AddChild(collision.AsNode())
+	mesh := BoxMesh.New()
+	mesh.SetSize(size)
+	instance := MeshInstance3D.New()
+	instance.SetMesh(mesh.AsMesh())
+	body.AsNode().AddChild(instance.AsNode())
+	return body
+}
+
+func main() {
+	classdb.Register[Player](NewPlayer)
+	startup.LoadingScene()
+	SceneTree.Add(NewLevel())
+	startup.Scene()
+}
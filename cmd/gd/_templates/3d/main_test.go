@@ -0,0 +1,41 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"graphics.gd/classdb"
+	"graphics.gd/startup"
+	"graphics.gd/variant/Vector2"
+	"graphics.gd/variant/Vector3"
+)
+
+// TestMain runs the tests inside the engine, run them with 'gd test'.
+func TestMain(m *testing.M) {
+	classdb.Register[Player](NewPlayer)
+	startup.LoadingScene()
+	os.Exit(m.Run())
+}
+
+func TestPlayerMove(t *testing.T) {
+	player := NewPlayer()
+	defer player.AsNode().QueueFree()
+	if v := player.Move(Vector3.Zero, Vector2.New(0, -1), false, true, 0.1); v.Z != -player.Speed {
+		t.Fatalf("expected the player to walk forwards, got %v", v)
+	}
+	if v := player.Move(Vector3.Zero, Vector2.Zero, true, true, 0.1); v.Y != player.JumpSpeed {
+		t.Fatalf("expected the player to jump, got %v", v)
+	}
+	if v := player.Move(Vector3.Zero, Vector2.Zero, false, false, 0.1); v.Y >= 0 {
+		t.Fatalf("expected the player to fall, got %v", v)
+	}
+}
+
+func TestPlayerLook(t *testing.T) {
+	player := NewPlayer()
+	defer player.AsNode().QueueFree()
+	player.Look(Vector2.New(0, 10000))
+	if tilt := player.head.AsNode3D().Rotation().X; tilt < -1.58 {
+		t.Fatalf("expected the camera tilt to be clamped, got %v", tilt)
+	}
+}
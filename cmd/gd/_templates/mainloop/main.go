@@ -0,0 +1,58 @@
+// This is an app with its own main loop, that draws directly with the RenderingServer each
+// frame, instead of using the SceneTree and nodes.
+package main
+
+import (
+	"graphics.gd/classdb/DisplayServer"
+	"graphics.gd/classdb/RenderingServer"
+	"graphics.gd/startup"
+	"graphics.gd/variant/Color"
+	"graphics.gd/variant/Float"
+	"graphics.gd/variant/RID"
+	"graphics.gd/variant/Rect2"
+	"graphics.gd/variant/Vector2"
+)
+
+// Ball bounces around the inside of a box.
+type Ball struct {
+	Position Vector2.XY
+	Velocity Vector2.XY // pixels per second.
+	Radius   Float.X
+}
+
+// Step moves the ball by delta seconds, bouncing off the edges of a box of the given size.
+func (ball *Ball) Step(delta Float.X, size Vector2.XY) {
+	ball.Position = Vector2.Add(ball.Position, Vector2.MulX(ball.Velocity, delta))
+	if ball.Position.X < ball.Radius || ball.Position.X > size.X-ball.Radius {
+		ball.Velocity.X = -ball.Velocity.X
+		ball.Position.X = Float.Clamp(ball.Position.X, ball.Radius, size.X-ball.Radius)
+	}
+	if ball.Position.Y < ball.Radius || ball.Position.Y > size.Y-ball.Radius {
+		ball.Velocity.Y = -ball.Velocity.Y
+		ball.Position.Y = Float.Clamp(ball.Position.Y, ball.Radius, size.Y-ball.Radius)
+	}
+}
+
+func main() {
+	frames := startup.Rendering() // waits for the engine to startup.
+	window := DisplayServer.WindowGetSize(0)
+	size := Vector2.New(window.X, window.Y)
+	viewport := RenderingServer.ViewportCreate()
+	RenderingServer.ViewportSetSize(viewport, int(window.X), int(window.Y))
+	RenderingServer.ViewportAttachToScreen(viewport, Rect2.New(0, 0, size.X, size.Y), 0)
+	RenderingServer.ViewportSetActive(viewport, true)
+	canvas := RenderingServer.CanvasCreate()
+	RenderingServer.ViewportAttachCanvas(viewport, canvas)
+	item := RenderingServer.CanvasItemCreate()
+	RenderingServer.CanvasItemSetParent(item, RID.CanvasItem(canvas))
+	ball := Ball{Position: Vector2.DivX(size, 2), Velocity: Vector2.New(240, 180), Radius: 24}
+	for delta := range frames {
+		ball.Step(delta, size)
+		RenderingServer.CanvasItemClear(item)
+		RenderingServer.CanvasItemAddRect(item, Rect2.New(ball.Position.X-ball.Radius, ball.Position.Y-ball.Radius,
+			ball.Radius*2, ball.Radius*2), Color.RGBA{R: 1, G: 0.5, B: 0.2, A: 1}, false)
+	}
+	RenderingServer.FreeRid(RID.Any(item))
+	RenderingServer.FreeRid(RID.Any(canvas))
+	RenderingServer.FreeRid(RID.Any(viewport))
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"graphics.gd/startup"
+	"graphics.gd/variant/Vector2"
+)
+
+// TestMain runs the tests inside the engine, run them with 'gd test'.
+func TestMain(m *testing.M) {
+	startup.LoadingScene()
+	os.Exit(m.Run())
+}
+
+func TestBallBounces(t *testing.T) {
+	box := Vector2.New(100, 100)
+	ball := Ball{Position: Vector2.New(90, 50), Velocity: Vector2.New(100, 0), Radius: 5}
+	ball.Step(0.1, box)
+	if ball.Velocity.X >= 0 {
+		t.Fatalf("expected the ball to bounce off the right edge, got %v", ball.Velocity)
+	}
+	if ball.Position.X > box.X-ball.Radius {
+		t.Fatalf("expected the ball to stay inside the box, got %v", ball.Position)
+	}
+	for range 1000 {
+		ball.Step(1.0/60, box)
+	}
+	if ball.Position.X < ball.Radius || ball.Position.X > box.X-ball.Radius {
+		t.Fatalf("expected the ball to stay inside the box, got %v", ball.Position)
+	}
+}
@@ -0,0 +1,49 @@
+// This is an editor plugin, run 'gd' without any arguments to open the editor, then use the
+// Project > Tools > Count Nodes menu item to count the nodes in the scene being edited.
+package main
+
+import (
+	"graphics.gd/classdb"
+	"graphics.gd/classdb/EditorInterface"
+	"graphics.gd/classdb/EditorPlugin"
+	"graphics.gd/classdb/Engine"
+	"graphics.gd/classdb/Node"
+	"graphics.gd/startup"
+)
+
+// NodeCounter adds the "Count Nodes" item to the editor's tool menu.
+type NodeCounter struct {
+	EditorPlugin.Extension[NodeCounter]
+}
+
+func (plugin *NodeCounter) EnterTree() {
+	plugin.AsEditorPlugin().AddToolMenuItem("Count Nodes", plugin.Count)
+}
+
+func (plugin *NodeCounter) ExitTree() {
+	plugin.AsEditorPlugin().RemoveToolMenuItem("Count Nodes")
+}
+
+// Count prints the number of nodes in the scene being edited.
+func (plugin *NodeCounter) Count() {
+	root := EditorInterface.GetEditedSceneRoot()
+	if root == (Node.Instance{}) {
+		Engine.RaiseWarning("Count Nodes: open a scene to count its nodes")
+		return
+	}
+	Engine.Println("Count Nodes:", CountNodes(root), "nodes in", root.Name())
+}
+
+// CountNodes returns the number of nodes in the tree rooted at node, including itself.
+func CountNodes(node Node.Instance) int {
+	count := 1
+	for i := range node.GetChildCount() {
+		count += CountNodes(node.GetChild(i))
+	}
+	return count
+}
+
+func main() {
+	classdb.Register[NodeCounter]() // editor plugins must be registered before the scene starts.
+	startup.Scene()
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"graphics.gd/classdb/Node"
+	"graphics.gd/startup"
+)
+
+// TestMain runs the tests inside the engine, run them with 'gd test'.
+func TestMain(m *testing.M) {
+	startup.LoadingScene()
+	os.Exit(m.Run())
+}
+
+func TestCountNodes(t *testing.T) {
+	root := Node.New()
+	defer root.QueueFree()
+	child := Node.New()
+	child.AddChild(Node.New())
+	root.AddChild(child)
+	root.AddChild(Node.New())
+	if n := CountNodes(root); n != 4 {
+		t.Fatalf("expected 4 nodes, got %d", n)
+	}
+}
@@ -0,0 +1,67 @@
+// This is a UI-only tool, that counts the lines, words and characters of the text typed into it.
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"graphics.gd/classdb"
+	"graphics.gd/classdb/Control"
+	"graphics.gd/classdb/Label"
+	"graphics.gd/classdb/MarginContainer"
+	"graphics.gd/classdb/SceneTree"
+	"graphics.gd/classdb/TextEdit"
+	"graphics.gd/classdb/VBoxContainer"
+	"graphics.gd/startup"
+)
+
+// Counter is a text box, with a summary of its text underneath.
+type Counter struct {
+	MarginContainer.Extension[Counter]
+
+	input   TextEdit.Instance
+	summary Label.Instance
+}
+
+// NewCounter returns a counter that fills the screen.
+func NewCounter() *Counter {
+	counter := &Counter{
+		input:   TextEdit.New(),
+		summary: Label.New(),
+	}
+	counter.AsControl().SetAnchorsPreset(Control.PresetFullRect)
+	for _, side := range []string{"left", "top", "right", "bottom"} {
+		counter.AsControl().AddThemeConstantOverride("margin_"+side, 16)
+	}
+	column := VBoxContainer.New()
+	counter.input.SetPlaceholderText("Type or paste some text here...")
+	counter.input.AsControl().SetSizeFlagsVertical(Control.SizeExpandFill)
+	counter.input.OnTextChanged(counter.Update)
+	column.AsNode().AddChild(counter.input.AsNode())
+	column.AsNode().AddChild(counter.summary.AsNode())
+	counter.AsNode().AddChild(column.AsNode())
+	counter.Update()
+	return counter
+}
+
+// Update the summary to match the text.
+func (c *Counter) Update() {
+	c.summary.SetText(Summarize(c.input.Text()))
+}
+
+// Summarize returns the number of lines, words and characters in the text.
+func Summarize(text string) string {
+	lines := strings.Count(text, "\n")
+	if text != "" && !strings.HasSuffix(text, "\n") {
+		lines++
+	}
+	return fmt.Sprintf("%d lines, %d words, %d characters", lines, len(strings.Fields(text)), utf8.RuneCountInString(text))
+}
+
+func main() {
+	classdb.Register[Counter](NewCounter)
+	startup.LoadingScene()
+	SceneTree.Add(NewCounter())
+	startup.Scene()
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"graphics.gd/classdb"
+	"graphics.gd/startup"
+)
+
+// TestMain runs the tests inside the engine, run them with 'gd test'.
+func TestMain(m *testing.M) {
+	classdb.Register[Counter](NewCounter)
+	startup.LoadingScene()
+	os.Exit(m.Run())
+}
+
+func TestSummarize(t *testing.T) {
+	for text, expected := range map[string]string{
+		"":                  "0 lines, 0 words, 0 characters",
+		"hello, world":      "1 lines, 2 words, 12 characters",
+		"one\ntwo three\n":  "2 lines, 3 words, 14 characters",
+		"graphics.gd ✓\nok": "2 lines, 3 words, 16 characters",
+	} {
+		if got := Summarize(text); got != expected {
+			t.Errorf("Summarize(%q) = %q, expected %q", text, got, expected)
+		}
+	}
+}
+
+func TestCounter(t *testing.T) {
+	counter := NewCounter()
+	defer counter.AsNode().QueueFree()
+	counter.input.SetText("hello, world")
+	counter.Update()
+	if got := counter.summary.Text(); got != Summarize("hello, world") {
+		t.Fatalf("unexpected summary %q", got)
+	}
+}
@@ -0,0 +1,305 @@
+package main
+
+import (
+	"archive/zip"
+	"bufio"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	_ "embed"
+
+	"runtime.link/api/xray"
+)
+
+// engines is a manifest of the SHA-512 sums of engine downloads, in the same format as the
+// SHA512-SUMS.txt published alongside each release of the engine. Downloads for releases
+// that are missing from the manifest are verified against the published sums instead.
+//
+//go:embed engines.sum
+var engines string
+
+// engineMirror is where engine releases are downloaded from, it can be overridden with
+// GDMIRROR, ie. to point at a local mirror. The SHA-512 sums of releases missing from the
+// manifest are always downloaded from here, so that a mirror cannot vouch for itself.
+const engineMirror = "https://github.com/godotengine/godot-builds/releases/download"
+
+// engineVersionPattern matches the versions that the engine can be pinned to, such as 4.4,
+// 4.4.1 or 4.5-beta1, as they end up in file paths and download URLs.
+var engineVersionPattern = regexp.MustCompile(`^[0-9]+\.[0-9]+(\.[0-9]+)?(-[a-z]+[0-9]*)?$`)
+
+// engineVersion returns the version of the engine that the project is pinned to, either with
+// a 'godot = "4.4"' line in graphics/gd.toml, or a '//gd:godot 4.4' directive in go.mod,
+// otherwise the default [version].
+func engineVersion(root, graphics string) (string, error) {
+	if toml, err := os.ReadFile(filepath.Join(graphics, "gd.toml")); err == nil {
+		for line := range strings.Lines(string(toml)) {
+			key, value, ok := strings.Cut(line, "=")
+			if ok && strings.TrimSpace(key) == "godot" {
+				value, _, _ = strings.Cut(value, "#")
+				return validEngineVersion(strings.Trim(strings.TrimSpace(value), `"'`), "graphics/gd.toml")
+			}
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return "", xray.New(err)
+	}
+	mod, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return "", xray.New(err)
+	}
+	for line := range strings.Lines(string(mod)) {
+		if pinned, ok := strings.CutPrefix(strings.TrimSpace(line), "//gd:godot "); ok {
+			return validEngineVersion(strings.TrimSpace(pinned), "go.mod")
+		}
+	}
+	return version, nil
+}
+
+func validEngineVersion(pinned, file string) (string, error) {
+	if !engineVersionPattern.MatchString(pinned) {
+		return "", fmt.Errorf("gd: invalid engine version %q in %s, expected a version like %s", pinned, file, version)
+	}
+	return pinned, nil
+}
+
+// engineTag returns the name of the release for the given version of the engine.
+func engineTag(version string) string {
+	if strings.Contains(version, "-") {
+		return version
+	}
+	return version + "-stable"
+}
+
+// engineBinary returns the name of the engine executable of the given release, that is
+// installed for the given GOARCH, it is distributed in a zip archive of the same name.
+func engineBinary(tag, goarch string) string {
+	arch := "x86_64"
+	if goarch == "arm64" {
+		arch = "arm64"
+	}
+	return "Godot_v" + tag + "_linux." + arch
+}
+
+// engineSource downloads engine releases into a cache directory that is shared between
+// projects, verifying each download against its SHA-512 sum.
+type engineSource struct {
+	mirror   string // base URL of the releases.
+	sums     string // base URL of the published SHA-512 sums of the releases.
+	cache    string // directory.
+	manifest string // bundled SHA-512 sums, see [engines].
+	offline  bool   // only use the cache.
+	client   *http.Client
+}
+
+// defaultEngineSource caches downloads under GDCACHE (or the user's cache directory) and is
+// offline when GDOFFLINE=1.
+func defaultEngineSource() (engineSource, error) {
+	cache := os.Getenv("GDCACHE")
+	if cache == "" {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			return engineSource{}, xray.New(err)
+		}
+		cache = filepath.Join(dir, "graphics.gd")
+	}
+	mirror := os.Getenv("GDMIRROR")
+	if mirror == "" {
+		mirror = engineMirror
+	}
+	return engineSource{
+		mirror:   strings.TrimSuffix(mirror, "/"),
+		sums:     engineMirror,
+		cache:    cache,
+		manifest: engines,
+		offline:  os.Getenv("GDOFFLINE") == "1",
+		client:   http.DefaultClient,
+	}, nil
+}
+
+// fetch returns the path to the named file of the given release in the cache, downloading
+// it first, if it is missing.
+func (src engineSource) fetch(tag, name string) (string, error) {
+	sum, err := src.checksum(tag, name)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(src.cache, name)
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		if src.offline {
+			return "", fmt.Errorf("gd: %s is not in the cache at %s (GDOFFLINE=1)", name, src.cache)
+		}
+		if err := src.download(src.mirror+"/"+tag+"/"+name, path); err != nil {
+			return "", err
+		}
+	} else if err != nil {
+		return "", xray.New(err)
+	}
+	if err := verify(path, sum); err != nil {
+		os.Remove(path)
+		return "", err
+	}
+	return path, nil
+}
+
+// checksum returns the SHA-512 sum of the named file of the given release, from the bundled
+// manifest, or else from the sums published with the release (never those of a GDMIRROR).
+// The published sums are downloaded each time that they are needed and never cached, so
+// that nothing can stand in for them on a later run.
+func (src engineSource) checksum(tag, name string) (string, error) {
+	if sum, ok := lookupSum(src.manifest, name); ok {
+		return sum, nil
+	}
+	if src.offline {
+		return "", fmt.Errorf("gd: cannot verify %s offline (GDOFFLINE=1), as %s is missing from the bundled SHA-512 sums", name, tag)
+	}
+	sums, err := src.get(src.sums + "/" + tag + "/SHA512-SUMS.txt")
+	if err != nil {
+		return "", fmt.Errorf("gd: cannot verify %s, there are no SHA-512 sums for %s: %w", name, tag, err)
+	}
+	if sum, ok := lookupSum(string(sums), name); ok {
+		return sum, nil
+	}
+	return "", fmt.Errorf("gd: cannot verify %s, it is missing from the SHA-512 sums for %s", name, tag)
+}
+
+// get the contents of the url.
+func (src engineSource) get(url string) ([]byte, error) {
+	resp, err := src.client.Get(url)
+	if err != nil {
+		return nil, xray.New(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("gd: failed to download %s: %v", url, resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, xray.New(err)
+	}
+	return body, nil
+}
+
+// download the url to path, resuming any previous partial download of it.
+func (src engineSource) download(url, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return xray.New(err)
+	}
+	partial := path + ".partial"
+	file, err := os.OpenFile(partial, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return xray.New(err)
+	}
+	defer file.Close()
+	offset, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return xray.New(err)
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return xray.New(err)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, err := src.client.Do(req)
+	if err != nil {
+		return xray.New(err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		fmt.Printf("gd: resuming download of %s\n", url)
+	case http.StatusOK:
+		fmt.Printf("gd: downloading %s\n", url)
+		if err := file.Truncate(0); err != nil {
+			return xray.New(err)
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return xray.New(err)
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		// already downloaded.
+	default:
+		return fmt.Errorf("gd: failed to download %s: %v", url, resp.Status)
+	}
+	if resp.StatusCode != http.StatusRequestedRangeNotSatisfiable {
+		if _, err := io.Copy(file, resp.Body); err != nil {
+			return fmt.Errorf("gd: download of %s was interrupted (run gd again to resume): %w", url, err)
+		}
+	}
+	if err := file.Close(); err != nil {
+		return xray.New(err)
+	}
+	return xray.New(os.Rename(partial, path))
+}
+
+// lookupSum returns the sum for the named file in a SHA512-SUMS.txt formatted manifest.
+func lookupSum(manifest, name string) (string, bool) {
+	scanner := bufio.NewScanner(strings.NewReader(manifest))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && !strings.HasPrefix(fields[0], "#") && strings.TrimPrefix(fields[1], "*") == name {
+			return strings.ToLower(fields[0]), true
+		}
+	}
+	return "", false
+}
+
+// verify that the file at path has the given SHA-512 sum.
+func verify(path, sum string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return xray.New(err)
+	}
+	defer file.Close()
+	hash := sha512.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return xray.New(err)
+	}
+	if got := hex.EncodeToString(hash.Sum(nil)); got != sum {
+		return fmt.Errorf("gd: %s failed SHA-512 verification, expected %s but got %s", filepath.Base(path), sum, got)
+	}
+	return nil
+}
+
+// extract the named file from the zip archive to dst, atomically, as an executable.
+func extract(archive, name, dst string) error {
+	r, err := zip.OpenReader(archive)
+	if err != nil {
+		return xray.New(err)
+	}
+	defer r.Close()
+	in, err := r.Open(name)
+	if err != nil {
+		return xray.New(err)
+	}
+	defer in.Close()
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return xray.New(err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(dst), "."+filepath.Base(dst)+"-*")
+	if err != nil {
+		return xray.New(err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, in); err != nil {
+		tmp.Close()
+		return xray.New(err)
+	}
+	if err := tmp.Chmod(0o755); err != nil {
+		tmp.Close()
+		return xray.New(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return xray.New(err)
+	}
+	return xray.New(os.Rename(tmp.Name(), dst))
+}
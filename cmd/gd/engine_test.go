@@ -0,0 +1,176 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// mirror serves a fake engine release, with http.ServeContent handling Range requests.
+func mirror(t *testing.T, files map[string][]byte) (*httptest.Server, *atomic.Int32, *atomic.Value) {
+	t.Helper()
+	var requests atomic.Int32
+	var ranged atomic.Value
+	ranged.Store("")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if header := r.Header.Get("Range"); header != "" {
+			ranged.Store(header)
+		}
+		data, ok := files[strings.TrimPrefix(r.URL.Path, "/4.4-stable/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, r.URL.Path, time.Time{}, bytes.NewReader(data))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests, &ranged
+}
+
+func fakeEngine(t *testing.T) ([]byte, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	w, err := archive.Create("Godot_v4.4-stable_linux.x86_64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write([]byte("#!/bin/sh\necho 4.4.stable\n"))
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	sum := sha512.Sum512(buf.Bytes())
+	return buf.Bytes(), hex.EncodeToString(sum[:])
+}
+
+func TestEngineDownload(t *testing.T) {
+	const name = "Godot_v4.4-stable_linux.x86_64.zip"
+	engine, sum := fakeEngine(t)
+	server, requests, ranged := mirror(t, map[string][]byte{name: engine})
+	src := engineSource{
+		mirror:   server.URL,
+		cache:    t.TempDir(),
+		manifest: sum + "  " + name + "\n",
+		client:   server.Client(),
+	}
+	// resume a partial download.
+	if err := os.WriteFile(filepath.Join(src.cache, name+".partial"), engine[:100], 0o644); err != nil {
+		t.Fatal(err)
+	}
+	path, err := src.fetch("4.4-stable", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ranged.Load(); got != "bytes=100-" {
+		t.Fatalf("expected the download to resume with a Range request, got %q", got)
+	}
+	dst := filepath.Join(t.TempDir(), "godot-4.4")
+	if err := extract(path, "Godot_v4.4-stable_linux.x86_64", dst); err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Stat(dst); err != nil || info.Mode()&0o111 == 0 {
+		t.Fatalf("expected an executable at %s: %v", dst, err)
+	}
+	// cached.
+	before := requests.Load()
+	if _, err := src.fetch("4.4-stable", name); err != nil {
+		t.Fatal(err)
+	}
+	if requests.Load() != before {
+		t.Fatal("expected the cached download to be reused")
+	}
+	// offline.
+	server.Close()
+	src.offline = true
+	if _, err := src.fetch("4.4-stable", name); err != nil {
+		t.Fatal(err)
+	}
+	os.Remove(path)
+	if _, err := src.fetch("4.4-stable", name); err == nil || !strings.Contains(err.Error(), "GDOFFLINE") {
+		t.Fatalf("expected an offline error, got %v", err)
+	}
+}
+
+func TestEngineChecksum(t *testing.T) {
+	const name = "Godot_v4.4-stable_linux.x86_64.zip"
+	engine, sum := fakeEngine(t)
+	server, _, _ := mirror(t, map[string][]byte{
+		name:              engine,
+		"SHA512-SUMS.txt": []byte(sum + "  " + name + "\n"),
+	})
+	src := engineSource{mirror: server.URL, sums: server.URL, cache: t.TempDir(), client: server.Client()}
+	if _, err := src.fetch("4.4-stable", name); err != nil {
+		t.Fatal(err)
+	}
+	// the published sums are not cached, so they cannot be replaced for later runs.
+	if cached, _ := os.ReadDir(src.cache); len(cached) != 1 || cached[0].Name() != name {
+		t.Fatalf("expected only %s to be cached, got %v", name, cached)
+	}
+	src.offline = true
+	if _, err := src.fetch("4.4-stable", name); err == nil || !strings.Contains(err.Error(), "GDOFFLINE") {
+		t.Fatalf("expected an unlisted release to be unverifiable offline, got %v", err)
+	}
+	src.offline = false
+	// a mirror cannot vouch for a tampered engine with its own sums.
+	tampered := append([]byte(nil), engine...)
+	tampered[len(tampered)-1]++
+	tamperedSum := sha512.Sum512(tampered)
+	evil, _, _ := mirror(t, map[string][]byte{
+		name:              tampered,
+		"SHA512-SUMS.txt": []byte(hex.EncodeToString(tamperedSum[:]) + "  " + name + "\n"),
+	})
+	mirrored := engineSource{mirror: evil.URL, sums: server.URL, cache: t.TempDir(), client: evil.Client()}
+	if _, err := mirrored.fetch("4.4-stable", name); err == nil || !strings.Contains(err.Error(), "SHA-512") {
+		t.Fatalf("expected a verification error, got %v", err)
+	}
+	src.cache = t.TempDir()
+	src.manifest = strings.Repeat("0", 128) + "  " + name + "\n"
+	if _, err := src.fetch("4.4-stable", name); err == nil || !strings.Contains(err.Error(), "SHA-512") {
+		t.Fatalf("expected a verification error, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(src.cache, name)); err == nil {
+		t.Fatal("expected the corrupt download to be removed")
+	}
+}
+
+func TestEngineManifest(t *testing.T) {
+	for _, goarch := range []string{"amd64", "arm64"} {
+		name := engineBinary(engineTag(version), goarch) + ".zip"
+		if _, ok := lookupSum(engines, name); !ok {
+			t.Errorf("engines.sum is missing the SHA-512 sum of %s, for the default version", name)
+		}
+	}
+}
+
+func TestEngineVersion(t *testing.T) {
+	root := t.TempDir()
+	graphics := filepath.Join(root, "graphics")
+	os.Mkdir(graphics, 0o755)
+	os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example\n\n//gd:godot 4.4.1\n\ngo 1.24\n"), 0o644)
+	if got, err := engineVersion(root, graphics); err != nil || got != "4.4.1" {
+		t.Fatalf("expected 4.4.1 from go.mod, got %q (%v)", got, err)
+	}
+	os.WriteFile(filepath.Join(graphics, "gd.toml"), []byte("# engine\ngodot = \"4.5-beta1\" # pinned\n"), 0o644)
+	if got, err := engineVersion(root, graphics); err != nil || got != "4.5-beta1" {
+		t.Fatalf("expected 4.5-beta1 from gd.toml, got %q (%v)", got, err)
+	}
+	for _, invalid := range []string{"../../bin/sh", "4.4/../..", "4", "latest", "4.4 --help"} {
+		os.WriteFile(filepath.Join(graphics, "gd.toml"), []byte("godot = \""+invalid+"\"\n"), 0o644)
+		if _, err := engineVersion(root, graphics); err == nil {
+			t.Fatalf("expected an error for the version %q", invalid)
+		}
+	}
+	if tag := engineTag("4.5-beta1"); tag != "4.5-beta1" {
+		t.Fatalf("unexpected tag %q", tag)
+	}
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"runtime.link/api/xray"
+)
+
+// exportTarget describes a platform that 'gd export' can produce a release build for.
+type exportTarget struct {
+	Preset   string // name of the preset in export_presets.cfg
+	GOOS     string
+	GOARCH   string
+	Artifact string // file name of the exported project, formatted with the project name.
+}
+
+var exportTargets = []exportTarget{
+	{"Linux", "linux", "amd64", "%s.x86_64"},
+	{"Linux ARM64", "linux", "arm64", "%s.arm64"},
+	{"Windows Desktop", "windows", "amd64", "%s.exe"},
+	{"macOS", "darwin", "arm64", "%s.zip"}, // universal, see the lipo step in wrap.
+	{"Web", "js", "wasm", "index.html"},
+}
+
+// lookupExport returns the export target for the given preset name or GOOS/GOARCH.
+func lookupExport(name string) (exportTarget, error) {
+	goos, goarch, isPlatform := strings.Cut(name, "/")
+	for _, target := range exportTargets {
+		if strings.EqualFold(name, target.Preset) {
+			return target, nil
+		}
+		if isPlatform && goos == target.GOOS && (goarch == target.GOARCH || goos == "darwin") {
+			return target, nil
+		}
+	}
+	var names []string
+	for _, target := range exportTargets {
+		names = append(names, fmt.Sprintf("%q (%s/%s)", target.Preset, target.GOOS, target.GOARCH))
+	}
+	return exportTarget{}, fmt.Errorf("gd: cannot export %q, the supported targets are: %s", name, strings.Join(names, ", "))
+}
+
+// exportPresetArgs returns the values to format the export_presets.cfg template with.
+func exportPresetArgs(project string) []any {
+	bundle := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return r
+		}
+		return '-'
+	}, project)
+	return []any{
+		filepath.Join(".godot", "godot.web.template_debug.wasm32.zip"),
+		"gd." + bundle,
+		filepath.Join(".godot", "godot.web.template_release.wasm32.zip"),
+	}
+}
+
+// presetBlocks splits an export_presets.cfg file into the sections belonging to each preset,
+// keyed by the name of the preset, along with the number of presets.
+func presetBlocks(cfg string) (map[string]string, int) {
+	var (
+		blocks = make(map[string]string)
+		block  strings.Builder
+		name   string
+		count  int
+	)
+	flush := func() {
+		if block.Len() > 0 {
+			blocks[name] = block.String()
+			block.Reset()
+		}
+	}
+	for line := range strings.Lines(cfg) {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[preset.") && !strings.HasSuffix(trimmed, ".options]") {
+			flush()
+			name = ""
+			count++
+		}
+		if value, ok := strings.CutPrefix(trimmed, "name="); ok && name == "" {
+			name = strings.Trim(value, `"`)
+		}
+		block.WriteString(line)
+	}
+	flush()
+	return blocks, count
+}
+
+// ensurePreset adds the preset for the target to the project's export_presets.cfg, if it is
+// missing, as projects created by older versions of 'gd' only include a debug Web preset.
+// The Web preset is updated to use the release template for release exports.
+func ensurePreset(path string, target exportTarget, project string) error {
+	existing, err := os.ReadFile(path)
+	if err != nil {
+		return xray.New(err)
+	}
+	cfg := string(existing)
+	blocks, count := presetBlocks(cfg)
+	args := exportPresetArgs(project)
+	if block, ok := blocks[target.Preset]; ok {
+		if target.GOOS != "js" {
+			return nil
+		}
+		// older projects leave the release template empty, or point it at the debug template.
+		fixed := block
+		for _, outdated := range []string{"", args[0].(string)} {
+			fixed = strings.Replace(fixed, fmt.Sprintf(`custom_template/release="%s"`, outdated), fmt.Sprintf(`custom_template/release="%s"`, args[2]), 1)
+		}
+		if fixed == block {
+			return nil
+		}
+		cfg = strings.Replace(cfg, block, fixed, 1)
+	} else {
+		templates, _ := presetBlocks(fmt.Sprintf(export_presets_cfg, args...))
+		block, ok := templates[target.Preset]
+		if !ok {
+			return fmt.Errorf("gd: missing %q preset in export_presets.cfg", target.Preset)
+		}
+		_, index, _ := strings.Cut(strings.TrimSpace(block[:strings.Index(block, "]")]), "[preset.")
+		block = strings.ReplaceAll(block, "[preset."+index+"]", fmt.Sprintf("[preset.%d]", count))
+		block = strings.ReplaceAll(block, "[preset."+index+".options]", fmt.Sprintf("[preset.%d.options]", count))
+		cfg = strings.TrimRight(cfg, "\n") + "\n\n" + block
+	}
+	return xray.New(os.WriteFile(path, []byte(cfg), 0o644))
+}
+
+// export the project for the target with the engine's headless release exporter, into an
+// 'export' directory alongside the go.mod, once the release library has been built.
+func export(godot, graphics, version string, target exportTarget) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return xray.New(err)
+	}
+	project := filepath.Base(wd)
+	if err := ensurePreset(filepath.Join(graphics, "export_presets.cfg"), target, project); err != nil {
+		return xray.New(err)
+	}
+	dir := filepath.Join(wd, "export", strings.ToLower(strings.ReplaceAll(target.Preset, " ", "-")))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return xray.New(err)
+	}
+	artifact := filepath.Join(dir, target.Artifact)
+	if strings.Contains(target.Artifact, "%s") {
+		artifact = filepath.Join(dir, fmt.Sprintf(target.Artifact, project))
+	}
+	fmt.Printf("gd: exporting %s to %s\n", target.Preset, dir)
+	cmd := exec.Command(godot, "--headless", "--export-release", target.Preset, artifact)
+	cmd.Dir = graphics
+	cmd.Stderr = os.Stderr
+	cmd.Stdout = os.Stdout
+	cmd.Stdin = os.Stdin
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("gd: failed to export %q (are the export templates for Godot v%s installed?): %w", target.Preset, version, err)
+	}
+	if target.GOOS == "js" {
+		for _, name := range []string{"library.wasm", "wasm_exec.js"} {
+			if err := copyFile(filepath.Join(dir, name), filepath.Join(graphics, ".godot", "public", name)); err != nil {
+				return xray.New(err)
+			}
+		}
+	}
+	return nil
+}
+
+func copyFile(dst, src string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return xray.New(err)
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return xray.New(err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return xray.New(err)
+	}
+	return xray.New(out.Close())
+}
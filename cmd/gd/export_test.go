@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLookupExport(t *testing.T) {
+	for name, preset := range map[string]string{
+		"linux/amd64":   "Linux",
+		"linux/arm64":   "Linux ARM64",
+		"windows/amd64": "Windows Desktop",
+		"darwin/amd64":  "macOS",
+		"darwin/arm64":  "macOS",
+		"js/wasm":       "Web",
+		"web":           "Web",
+		"Linux ARM64":   "Linux ARM64",
+	} {
+		target, err := lookupExport(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if target.Preset != preset {
+			t.Fatalf("lookupExport(%q) = %q, expected %q", name, target.Preset, preset)
+		}
+	}
+	for _, name := range []string{"plan9/amd64", "linux/386", "Android"} {
+		if _, err := lookupExport(name); err == nil {
+			t.Fatalf("expected an error for %q", name)
+		}
+	}
+}
+
+func TestPresetBlocks(t *testing.T) {
+	blocks, count := presetBlocks(fmt.Sprintf(export_presets_cfg, exportPresetArgs("game")...))
+	if count != len(exportTargets) {
+		t.Fatalf("expected %d presets, got %d", len(exportTargets), count)
+	}
+	for _, target := range exportTargets {
+		block, ok := blocks[target.Preset]
+		if !ok {
+			t.Fatalf("missing %q preset", target.Preset)
+		}
+		if !strings.Contains(block, "[preset.") || !strings.Contains(block, ".options]") {
+			t.Fatalf("expected the %q block to include its options:\n%s", target.Preset, block)
+		}
+	}
+	if !strings.Contains(blocks["Web"], `custom_template/release=".godot/godot.web.template_release.wasm32.zip"`) {
+		t.Fatalf("expected the Web preset to use the release template:\n%s", blocks["Web"])
+	}
+}
+
+func TestEnsurePreset(t *testing.T) {
+	// as created by older versions of 'gd', with only a debug Web preset.
+	web, _ := presetBlocks(fmt.Sprintf(export_presets_cfg, exportPresetArgs("game")...))
+	legacy := strings.Replace(web["Web"], "template_release", "template_debug", 1)
+	path := filepath.Join(t.TempDir(), "export_presets.cfg")
+	if err := os.WriteFile(path, []byte(legacy), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	linux, _ := lookupExport("linux/amd64")
+	if err := ensurePreset(path, linux, "game"); err != nil {
+		t.Fatal(err)
+	}
+	cfg, _ := os.ReadFile(path)
+	blocks, count := presetBlocks(string(cfg))
+	if count != 2 || !strings.Contains(blocks["Linux"], "[preset.1]") || !strings.Contains(blocks["Linux"], "[preset.1.options]") {
+		t.Fatalf("expected the Linux preset to be added as preset 1:\n%s", cfg)
+	}
+	webTarget, _ := lookupExport("js/wasm")
+	if err := ensurePreset(path, webTarget, "game"); err != nil {
+		t.Fatal(err)
+	}
+	cfg, _ = os.ReadFile(path)
+	if !strings.Contains(string(cfg), `custom_template/release=".godot/godot.web.template_release.wasm32.zip"`) {
+		t.Fatalf("expected the Web preset to be updated to the release template:\n%s", cfg)
+	}
+	// existing presets are left alone.
+	if err := ensurePreset(path, linux, "game"); err != nil {
+		t.Fatal(err)
+	}
+	again, _ := os.ReadFile(path)
+	if string(again) != string(cfg) {
+		t.Fatalf("expected no changes, got:\n%s", again)
+	}
+}
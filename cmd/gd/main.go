@@ -7,12 +7,35 @@
 // keep the graphical representation of their project and manage their assets. Running the
 // command without any command line arguments will launch the Godot editor for managing
 // the assets in this directory.
+//
+// 'gd export <preset|GOOS/GOARCH>' builds a stripped release library for the given preset in
+// graphics/export_presets.cfg (or the preset for the given platform) and then uses the
+// engine's headless exporter to produce a ready-to-ship project under the 'export' directory.
+// The export templates for the engine must be installed (for example, via the editor) and
+// cross-compiling for another platform requires a C toolchain for it, see CC in 'go help environment'.
+//
+// 'gd run -watch' rebuilds the library and restarts the engine whenever the Go files of the
+// module change, whereas 'gd -watch' opens the editor with a library built with the 'reloads'
+// tag, so that the editor reloads the Go code in place (see graphics.gd/startup).
+//
+// 'gd test' runs the tests of the package inside a headless engine and accepts the flags of 'go test',
+// including -json (test2json events), -cover and -coverprofile (written to the module directory).
+// 'gd test -junit <file>' also writes a JUnit XML report and the exit code reflects the test results,
+// even when the engine exits successfully.
+//
+// 'gd new [template] <dir>' creates a new project from one of the templates in _templates,
+// run it without arguments to list them.
+//
+// The version of the engine can be pinned with a 'godot = "4.4"' line in graphics/gd.toml or a
+// '//gd:godot 4.4' directive in go.mod. Engine downloads are verified against their SHA-512 sums
+// and cached under GDCACHE (defaults to the user's cache directory), GDOFFLINE=1 only uses this
+// cache and GDMIRROR changes where releases are downloaded from (their sums are still checked
+// against the official release).
 package main
 
 import (
-	"archive/zip"
 	"bufio"
-	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"go/build"
@@ -21,8 +44,10 @@ import (
 	"net/http"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"time"
 
@@ -32,7 +57,7 @@ import (
 	"runtime.link/api/xray"
 )
 
-const version = "4.4"
+const version = "4.4" // default, see engineVersion
 
 // These are our initial Godot project template files, we create
 // these automatically when the user runs the 'gd' command. They
@@ -74,7 +99,7 @@ func main() {
 	}
 }
 
-func installGodot(gobin string) (string, error) {
+func installGodot(gobin, version string) (string, error) {
 	switch runtime.GOOS {
 	case "android":
 		return "echo", nil
@@ -88,36 +113,19 @@ func installGodot(gobin string) (string, error) {
 		}
 		return "godot", nil
 	case "linux":
-		fmt.Println("gd: downloading Godot v" + version + " stable for linux")
-		resp, err := http.Get("https://github.com/godotengine/godot-builds/releases/download/" + version + "-stable/Godot_v" + version + "-stable_linux.x86_64.zip")
+		src, err := defaultEngineSource()
 		if err != nil {
 			return "", xray.New(err)
 		}
-		defer resp.Body.Close()
-		if resp.StatusCode != 200 {
-			return "", xray.New(err)
-		}
-		data, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return "", xray.New(err)
-		}
-		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		tag := engineTag(version)
+		binary := engineBinary(tag, runtime.GOARCH)
+		fmt.Println("gd: installing Godot v" + tag + " for linux")
+		archive, err := src.fetch(tag, binary+".zip")
 		if err != nil {
-			return "", xray.New(err)
+			return "", err
 		}
-		inZip, err := archive.Open("Godot_v" + version + "-stable_linux.x86_64")
-		if err != nil {
-			return "", xray.New(err)
-		}
-		defer inZip.Close()
-		//executable
 		binPath := filepath.Join(gobin, "godot-"+version)
-		file, err := os.OpenFile(binPath, os.O_CREATE|os.O_WRONLY, 0755)
-		if err != nil {
-			return "", xray.New(err)
-		}
-		defer file.Close()
-		if _, err = io.Copy(file, inZip); err != nil {
+		if err := extract(archive, binary, binPath); err != nil {
 			return "", xray.New(err)
 		}
 		return binPath, nil
@@ -136,7 +144,7 @@ func (s WebServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	s.Handler.ServeHTTP(w, r)
 }
 
-func useGodot() (string, error) {
+func useGodot(version string) (string, error) {
 	gopath := os.Getenv("GOPATH")
 	if gopath == "" {
 		gopath = build.Default.GOPATH
@@ -157,13 +165,13 @@ func useGodot() (string, error) {
 	if binary, err := exec.LookPath("godot-" + version); err == nil {
 		return binary, nil
 	}
-	godotBin := filepath.Join(gobin + "godot-" + version)
+	godotBin := filepath.Join(gobin, "godot-"+version)
 	info, err := os.Stat(godotBin)
 	if err != nil {
 		if !errors.Is(err, fs.ErrNotExist) {
 			return "", xray.New(err)
 		}
-		godot, err := installGodot(gobin)
+		godot, err := installGodot(gobin, version)
 		if err != nil {
 			return "", xray.New(err)
 		}
@@ -174,10 +182,44 @@ func useGodot() (string, error) {
 			return "", xray.New(err)
 		}
 	}
-	return filepath.Join(gobin, "godot-"+version), nil
+	return godotBin, nil
+}
+
+// downloadWebTemplate downloads the graphics.gd web export template of the given kind
+// (template_debug or template_release) into the .godot directory, unless it is up to date.
+func downloadWebTemplate(graphics, kind string) error {
+	name := "godot.web." + kind + ".wasm32.zip"
+	template_path := filepath.Join(graphics, ".godot", name)
+	stat, statErr := os.Stat(template_path)
+	resp, err := http.Get("https://graphics.gd/" + name)
+	if err != nil {
+		return xray.New(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("gd: failed to download %s: %v (is your gd command out of date?)", name, resp.Status)
+	}
+	last_modified, err := time.Parse(http.TimeFormat, resp.Header.Get("Last-Modified"))
+	if err != nil {
+		return xray.New(err)
+	}
+	if os.IsNotExist(statErr) || (statErr == nil && last_modified.After(stat.ModTime())) {
+		fmt.Println("gd: downloading latest graphics.gd/" + name)
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return xray.New(err)
+		}
+		if err := os.WriteFile(template_path, data, 0o644); err != nil {
+			return xray.New(err)
+		}
+	}
+	return nil
 }
 
 func wrap() error {
+	if len(os.Args) > 1 && os.Args[1] == "new" {
+		return newProject(os.Args[2:])
+	}
 	GOOS, GOARCH := runtime.GOOS, runtime.GOARCH
 	if os.Getenv("GOOS") != "" {
 		GOOS = os.Getenv("GOOS")
@@ -185,24 +227,33 @@ func wrap() error {
 	if os.Getenv("GOARCH") != "" {
 		GOARCH = os.Getenv("GOARCH")
 	}
+	var target exportTarget
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if len(os.Args) != 3 {
+			return errors.New("usage: gd export <preset|GOOS/GOARCH>")
+		}
+		var err error
+		if target, err = lookupExport(os.Args[2]); err != nil {
+			return err
+		}
+		GOOS, GOARCH = target.GOOS, target.GOARCH
+	}
 	if GOARCH != "amd64" && GOARCH != "arm64" && GOARCH != "wasm" {
 		return errors.New("gd requires an amd64, wasm, or arm64 system")
 	}
-	godot, err := useGodot()
-	if err != nil {
-		return fmt.Errorf("gd requires Godot v%s to be installed as a binary at $GOPATH/bin/godot-%s: %w", version, version, err)
-	}
 	wd, err := os.Getwd()
 	if err != nil {
 		return xray.New(err)
 	}
 	// look for a go.mod file
+	var root string
 	for wd := wd; true; wd = filepath.Dir(wd) {
 		if wd == "/" {
 			return fmt.Errorf("gd requires your project to have a go.mod file")
 		}
 		_, err := os.Stat(wd + "/go.mod")
 		if err == nil {
+			root = wd
 			break
 		} else if os.IsNotExist(err) {
 			continue
@@ -214,6 +265,14 @@ func wrap() error {
 	if GOOS == "android" {
 		graphics = "/sdcard/gd/" + filepath.Base(wd)
 	}
+	version, err := engineVersion(root, graphics)
+	if err != nil {
+		return xray.New(err)
+	}
+	godot, err := useGodot(version)
+	if err != nil {
+		return fmt.Errorf("gd requires Godot v%s to be installed as a binary at $GOPATH/bin/godot-%s: %w", version, version, err)
+	}
 	setup := func() error {
 		if GOOS == "js" {
 			if err := os.MkdirAll(graphics+"/.godot/public", 0o755); err != nil {
@@ -243,29 +302,12 @@ func wrap() error {
 					return xray.New(err)
 				}
 			}
-			template_path := filepath.Join(graphics, ".godot", "godot.web.template_debug.wasm32.zip")
-			stat, statErr := os.Stat(template_path)
-			resp, err := http.Get("https://graphics.gd/godot.web.template_debug.wasm32.zip")
-			if err != nil && !os.IsNotExist(err) {
-				return xray.New(err)
-			} else {
-				defer resp.Body.Close()
+			template := "template_debug"
+			if target.Preset != "" {
+				template = "template_release"
 			}
-			last_modified, err := time.Parse(http.TimeFormat, resp.Header.Get("Last-Modified"))
-			if err != nil {
-				return xray.New(err)
-			}
-			if (os.IsNotExist(statErr) || (statErr == nil && last_modified.After(stat.ModTime()))) && resp.Body != nil {
-				fmt.Println("gd: downloading latest graphics.gd/godot.web.template_debug.wasm32.zip")
-				data, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return xray.New(err)
-				}
-				if err := os.WriteFile(template_path, data, 0o644); err != nil {
-					return xray.New(err)
-				}
-			} else if resp.Body == nil || resp.StatusCode != 200 {
-				return fmt.Errorf("gd: failed to download godot.web.template_debug.wasm32.zip: %v (is your gd command out of date?)", resp.Status)
+			if err := downloadWebTemplate(graphics, template); err != nil {
+				return err
 			}
 		}
 		if err := setupFile(false, graphics+"/main.tscn", main_tscn); err != nil {
@@ -274,9 +316,7 @@ func wrap() error {
 		if err := setupFile(false, graphics+"/project.godot", project_godot, filepath.Base(wd)); err != nil {
 			return xray.New(err)
 		}
-		if err := setupFile(false, graphics+"/export_presets.cfg", export_presets_cfg, filepath.Join(
-			".godot", "godot.web.template_debug.wasm32.zip",
-		)); err != nil {
+		if err := setupFile(false, graphics+"/export_presets.cfg", export_presets_cfg, exportPresetArgs(filepath.Base(wd))...); err != nil {
 			return xray.New(err)
 		}
 		if err := setupFile(true, graphics+"/library.gdextension", library_gdextension); err != nil {
@@ -297,25 +337,45 @@ func wrap() error {
 	}
 	var runGodotArgs []string
 	var libraryPath = graphics + "/" + fmt.Sprintf("%v_%v", GOOS, GOARCH)
+	var variant string // suffix of release libraries, see library.gdextension
+	if target.Preset != "" {
+		variant = ".release"
+	}
 	switch GOOS {
 	case "windows":
-		libraryPath += ".dll"
+		libraryPath += variant + ".dll"
 	case "darwin":
-		libraryPath += ".dylib"
+		libraryPath += variant + ".dylib"
 	case "js":
 		libraryPath = filepath.Join(graphics, ".godot", "public", "library.wasm")
 		runGodotArgs = []string{"--headless", "--export-debug", "Web"}
 	case "android":
-		libraryPath = "lib" + libraryPath + ".so"
+		libraryPath = "lib" + libraryPath + variant + ".so"
 	default:
-		libraryPath += ".so"
+		libraryPath += variant + ".so"
+	}
+	var watch bool // rebuild when the Go files change, see watchProject
+	if len(os.Args) == 2 && os.Args[1] == "-watch" {
+		os.Args = os.Args[:1]
+		watch = true
+	}
+	if len(os.Args) > 2 && os.Args[1] == "run" {
+		if i := slices.Index(os.Args, "-watch"); i > 1 {
+			os.Args = slices.Delete(os.Args, i, i+1)
+			watch = true
+		}
+	}
+	if watch && GOOS == "js" {
+		return errors.New("gd: -watch is not supported for GOOS=js")
 	}
-	if len(os.Args) == 1 {
+	editor := len(os.Args) == 1
+	if editor {
 		os.Args = append(os.Args, "run")
 		runGodotArgs = []string{"-e"}
 	}
 	args := make([]string, len(os.Args)-1)
 	builds := [][]string{}
+	var tests testFlags
 	switch os.Args[1] {
 	case "fix":
 		return fix()
@@ -326,8 +386,20 @@ func wrap() error {
 		if GOOS != "js" {
 			args = append(args, "-buildmode=c-shared")
 		}
+		if watch && editor {
+			args = append(args, "-tags=reloads") // the extension reloads itself, see startup/reloads.go
+		}
+	case "export":
+		args = []string{"build", "-trimpath", "-ldflags=-s -w", "-o", libraryPath}
+		if GOOS != "js" {
+			args = append(args, "-buildmode=c-shared")
+		}
 	case "test":
-		args = []string{"test", "-c", "-o", libraryPath}
+		tests, err = parseTestFlags(root, os.Args[2:])
+		if err != nil {
+			return err
+		}
+		args = append([]string{"test", "-c", "-o", libraryPath}, tests.build...)
 		if GOOS != "js" {
 			args = append(args, "-buildmode=c-shared")
 		} else {
@@ -338,79 +410,68 @@ func wrap() error {
 	}
 	builds = append(builds, args)
 	arches := []string{GOARCH}
-	if runtime.GOOS == "darwin" && (GOARCH == "amd64" || GOARCH == "arm64") {
+	if GOOS == "darwin" && (GOARCH == "amd64" || GOARCH == "arm64") {
 		// GOARCH possible values = "amd64", "arm64"
 		missingArch := "arm64"
 		if GOARCH == "arm64" {
 			missingArch = "amd64"
 		}
 		missingArgs := make([]string, len(os.Args)-1)
-		missingLibraryName := fmt.Sprintf("%v_%v.dylib", GOOS, missingArch)
+		missingLibraryName := fmt.Sprintf("%v_%v%v.dylib", GOOS, missingArch, variant)
 		missingArgs = append(args, "-buildmode=c-shared", "-o", graphics+"/"+missingLibraryName)
 		builds = append(builds, missingArgs)
 		arches = append(arches, missingArch)
 	}
-	for i, commandArgs := range builds {
-		var undefinedSymbols []string
-		var parsingDone = make(chan struct{})
-		stderr, capture, err := os.Pipe()
-		if err != nil {
-			return xray.New(err)
+	build := func() error {
+		for i, commandArgs := range builds {
+			if err := compile(commandArgs, GOOS, arches[i]); err != nil {
+				return err
+			}
 		}
-		go func() {
-			defer stderr.Close()
-			defer close(parsingDone)
-			scanner := bufio.NewScanner(stderr)
-			for scanner.Scan() {
-				line := scanner.Text()
-				fmt.Fprintln(os.Stderr, line)
-				_, ident, ok := strings.Cut(line, "undefined: ")
-				if ok {
-					undefinedSymbols = append(undefinedSymbols, ident)
-				}
+		if GOOS == "darwin" && (GOARCH == "amd64" || GOARCH == "arm64") {
+			// check if command is available in the system
+			_, err := exec.LookPath("lipo")
+			if err != nil {
+				return fmt.Errorf("gd: lipo command not found in the system, please install it!")
+			}
+			lipoCommand := exec.Command("lipo", "-create", graphics+"/darwin_amd64"+variant+".dylib", graphics+"/darwin_arm64"+variant+".dylib", "-output", graphics+"/darwin_universal"+variant+".dylib")
+			lipoCommand.Stderr = os.Stderr
+			lipoCommand.Stdout = os.Stdout
+			lipoCommand.Stdin = os.Stdin
+			if err := lipoCommand.Run(); err != nil {
+				return err
 			}
-		}()
-		golang := exec.Command("go", commandArgs...)
-		golang.Env = os.Environ()
-		if GOOS != "js" {
-			golang.Env = append(os.Environ(), "CGO_ENABLED=1")
-		}
-		golang.Env = append(golang.Env, "GOARCH="+arches[i])
-		golang.Stderr = capture
-		golang.Stdout = os.Stdout
-		golang.Stdin = os.Stdin
-		if err := golang.Run(); err != nil {
-			capture.Close()
-			<-parsingDone
-			checkForFixes(undefinedSymbols)
-			return err
 		}
+		return nil
 	}
-	if runtime.GOOS == "darwin" && (GOARCH == "amd64" || GOARCH == "arm64") {
-		// check if command is available in the system
-		_, err := exec.LookPath("lipo")
-		if err != nil {
-			return fmt.Errorf("gd: lipo command not found in the system, please install it!")
-		}
-		lipoCommand := exec.Command("lipo", "-create", graphics+"/darwin_amd64.dylib", graphics+"/darwin_arm64.dylib", "-output", graphics+"/darwin_universal.dylib")
-		lipoCommand.Stderr = os.Stderr
-		lipoCommand.Stdout = os.Stdout
-		lipoCommand.Stdin = os.Stdin
-		if err := lipoCommand.Run(); err != nil {
-			return err
-		}
+	buildErr := build()
+	if buildErr != nil && !(watch && !editor) {
+		return buildErr
 	}
 	if err := setup(); err != nil {
 		return xray.New(err)
 	}
 	switch os.Args[1] {
+	case "export":
+		return export(godot, graphics, version, target)
 	case "run":
-		godot := exec.Command(godot, runGodotArgs...)
-		godot.Dir = graphics
-		godot.Stderr = os.Stderr
-		godot.Stdout = os.Stdout
-		godot.Stdin = os.Stdin
-		if err := godot.Run(); err != nil {
+		command := func() *exec.Cmd {
+			godot := exec.Command(godot, runGodotArgs...)
+			godot.Dir = graphics
+			godot.Stderr = os.Stderr
+			godot.Stdout = os.Stdout
+			godot.Stdin = os.Stdin
+			return godot
+		}
+		if watch && !editor {
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer cancel()
+			return watchProject(ctx, root, buildErr == nil, build, command)
+		}
+		if watch {
+			fmt.Println("gd: the editor will reload the Go code of the project whenever it changes")
+		}
+		if err := command().Run(); err != nil {
 			return xray.New(err)
 		}
 		if GOOS == "js" {
@@ -424,28 +485,48 @@ func wrap() error {
 		}
 		return nil
 	case "test":
-		args := []string{"--headless"}
-		for _, arg := range os.Args[2:] {
-			switch arg {
-			case "-bench", "-benchmem", "-benchtime", "blockprofile",
-				"-blockprofilerate", "-count", "-coverprofile", "-cpu",
-				"-cpuprofile", "-failfast", "-fullpath", "-fuzz", "-fuzzcachedir",
-				"-fuzzminimizetime", "-fuzztime", "-fuzzworker", "-gocoverdir",
-				"-list", "-memprofile", "-memprofilerate", "-mutexprofile",
-				"-mutexprofilefraction", "-outputdir", "-paniconexit0",
-				"-parallel", "-run", "-short", "-shuffle", "-skip", "-testlogfile",
-				"-timeout", "-trace", "-v":
-				args = append(args, "-test."+strings.TrimPrefix(arg, "-"))
-			default:
-				args = append(args, arg)
+		return runTests(godot, graphics, tests)
+	}
+	return nil
+}
+
+// compile runs the go command with the given arguments, streaming its errors to stderr and
+// suggesting 'gd fix' if any of them refer to deprecated symbols.
+func compile(args []string, GOOS, GOARCH string) error {
+	var undefinedSymbols []string
+	var parsingDone = make(chan struct{})
+	stderr, capture, err := os.Pipe()
+	if err != nil {
+		return xray.New(err)
+	}
+	go func() {
+		defer stderr.Close()
+		defer close(parsingDone)
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			line := scanner.Text()
+			fmt.Fprintln(os.Stderr, line)
+			_, ident, ok := strings.Cut(line, "undefined: ")
+			if ok {
+				undefinedSymbols = append(undefinedSymbols, ident)
 			}
 		}
-		godot := exec.Command(godot, args...)
-		godot.Dir = graphics
-		godot.Stderr = os.Stderr
-		godot.Stdout = os.Stdout
-		godot.Stdin = os.Stdin
-		return xray.New(godot.Run())
+	}()
+	golang := exec.Command("go", args...)
+	golang.Env = os.Environ()
+	if GOOS != "js" {
+		golang.Env = append(os.Environ(), "CGO_ENABLED=1")
+	}
+	golang.Env = append(golang.Env, "GOOS="+GOOS, "GOARCH="+GOARCH)
+	golang.Stderr = capture
+	golang.Stdout = os.Stdout
+	golang.Stdin = os.Stdin
+	err = golang.Run()
+	capture.Close()
+	<-parsingDone
+	if err != nil {
+		checkForFixes(undefinedSymbols)
+		return err
 	}
 	return nil
 }
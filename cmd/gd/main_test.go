@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// fakeGodot puts a 'godot' on the PATH that reports the default engine version and records
+// the arguments it was run with into the returned file.
+func fakeGodot(t *testing.T) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+	bin := t.TempDir()
+	record := filepath.Join(bin, "args")
+	script := "#!/bin/sh\nif [ \"$1\" = --version ]; then echo " + version + ".stable.official; exit 0; fi\necho \"$@\" >> " + record + "\n"
+	if err := os.WriteFile(filepath.Join(bin, "godot"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return record
+}
+
+// TestWrapEditor checks that running 'gd' without any arguments builds the library and
+// launches the editor.
+func TestWrapEditor(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a c-shared library")
+	}
+	record := fakeGodot(t)
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"go.mod":  "module example.com/game\n\ngo 1.24\n",
+		"main.go": "package main\n\nfunc main() {}\n",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "graphics", ".godot"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+	t.Setenv("GOOS", "")
+	t.Setenv("GOARCH", "")
+	args := os.Args
+	defer func() { os.Args = args }()
+	os.Args = []string{"gd"}
+	if err := wrap(); err != nil {
+		t.Fatal(err)
+	}
+	ran, err := os.ReadFile(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(string(ran)) != "-e" {
+		t.Fatalf("expected the editor to be launched, got %q", ran)
+	}
+}
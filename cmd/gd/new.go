@@ -0,0 +1,137 @@
+package main
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+
+	"runtime.link/api/xray"
+)
+
+// templates for 'gd new', each is a main package with a starter test that runs under 'gd test'.
+//
+//go:embed _templates
+var templates embed.FS
+
+// templateNames describes each of the templates, the first is the default.
+var templateNames = [][2]string{
+	{"2d", "2D platformer"},
+	{"3d", "3D first-person"},
+	{"ui", "UI-only tool"},
+	{"plugin", "editor plugin"},
+	{"mainloop", "custom main loop, drawing with the RenderingServer each frame"},
+}
+
+// newProject implements 'gd new [template] <dir>'.
+func newProject(args []string) error {
+	template := templateNames[0][0]
+	var dir string
+	switch len(args) {
+	case 1:
+		dir = args[0]
+	case 2:
+		template, dir = args[0], args[1]
+	default:
+		var usage strings.Builder
+		usage.WriteString("usage: gd new [template] <dir>\n\ntemplates:\n")
+		for _, name := range templateNames {
+			fmt.Fprintf(&usage, "\t%-10s %s\n", name[0], name[1])
+		}
+		return errors.New(strings.TrimSpace(usage.String()))
+	}
+	if err := scaffold(template, dir); err != nil {
+		return err
+	}
+	for _, args := range [][]string{{"get", "graphics.gd@" + moduleVersion()}, {"mod", "tidy"}} {
+		golang := exec.Command("go", args...)
+		golang.Dir = dir
+		golang.Stderr = os.Stderr
+		golang.Stdout = os.Stdout
+		if err := golang.Run(); err != nil {
+			return fmt.Errorf("gd: failed to 'go %s' in %s: %w", strings.Join(args, " "), dir, err)
+		}
+	}
+	fmt.Printf("gd: created a new %s project in %s, cd into it and use 'gd run' to get started\n", template, dir)
+	return nil
+}
+
+// moduleVersion returns the version of graphics.gd that this command was built from.
+func moduleVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Path == "graphics.gd" {
+		if info.Main.Version != "" && info.Main.Version != "(devel)" {
+			return info.Main.Version
+		}
+	}
+	return "master"
+}
+
+// scaffold creates a new project in dir from the named template, along with its go.mod and the
+// graphics directory for the engine.
+func scaffold(template, dir string) error {
+	files, err := fs.Sub(templates, path.Join("_templates", template))
+	if err != nil {
+		return xray.New(err)
+	}
+	if _, err := fs.Stat(files, "main.go"); err != nil {
+		var names []string
+		for _, name := range templateNames {
+			names = append(names, name[0])
+		}
+		return fmt.Errorf("gd: unknown template %q, expected one of: %s", template, strings.Join(names, ", "))
+	}
+	if entries, err := os.ReadDir(dir); err == nil && len(entries) > 0 {
+		return fmt.Errorf("gd: cannot create a new project in %s, as it is not empty", dir)
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return xray.New(err)
+	}
+	project := filepath.Base(abs)
+	graphics := filepath.Join(dir, "graphics")
+	if err := os.MkdirAll(graphics, 0o755); err != nil {
+		return xray.New(err)
+	}
+	module := strings.Map(func(r rune) rune {
+		if strings.ContainsRune("abcdefghijklmnopqrstuvwxyz0123456789-._~", r) {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(project))
+	if err := setupFile(true, filepath.Join(dir, "go.mod"), "module %s\n\ngo 1.24\n", module); err != nil {
+		return xray.New(err)
+	}
+	if err := fs.WalkDir(files, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		data, err := fs.ReadFile(files, name)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dir, filepath.FromSlash(name)), data, 0o644)
+	}); err != nil {
+		return xray.New(err)
+	}
+	for _, file := range []struct {
+		name  string
+		embed string
+		args  []any
+	}{
+		{"project.godot", project_godot, []any{project}},
+		{"main.tscn", main_tscn, nil},
+		{"library.gdextension", library_gdextension, nil},
+		{"export_presets.cfg", export_presets_cfg, exportPresetArgs(project)},
+	} {
+		if err := setupFile(true, filepath.Join(graphics, file.name), file.embed, file.args...); err != nil {
+			return xray.New(err)
+		}
+	}
+	return nil
+}
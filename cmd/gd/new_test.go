@@ -0,0 +1,53 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestScaffold(t *testing.T) {
+	root, err := filepath.Abs("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, template := range templateNames {
+		t.Run(template[0], func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "My Game")
+			if err := scaffold(template[0], dir); err != nil {
+				t.Fatal(err)
+			}
+			for _, name := range []string{"go.mod", "main.go", "main_test.go", "graphics/project.godot", "graphics/library.gdextension"} {
+				if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := scaffold(template[0], dir); err == nil {
+				t.Fatal("expected an error when the directory is not empty")
+			}
+			mod, _ := os.ReadFile(filepath.Join(dir, "go.mod"))
+			if !strings.HasPrefix(string(mod), "module my-game\n") {
+				t.Fatalf("unexpected go.mod:\n%s", mod)
+			}
+			if testing.Short() {
+				return
+			}
+			// the template must compile against this version of graphics.gd
+			mod = append(mod, "\nrequire graphics.gd v0.0.0\n\nreplace graphics.gd => "+root+"\n"...)
+			os.WriteFile(filepath.Join(dir, "go.mod"), mod, 0o644)
+			os.WriteFile(filepath.Join(dir, "go.sum"), sum, 0o644)
+			vet := exec.Command("go", "vet", ".")
+			vet.Dir = dir
+			vet.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+			if out, err := vet.CombinedOutput(); err != nil {
+				t.Fatalf("%v\n%s", err, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"runtime.link/api/xray"
+)
+
+// testFlags are the command line flags of 'gd test', split into those for building the test
+// binary and those for running it inside the engine.
+type testFlags struct {
+	build    []string // for 'go test -c'
+	run      []string // for the test binary.
+	json     bool     // print test2json events, like 'go test -json'.
+	junit    string   // path to write a JUnit XML report to.
+	coverage bool     // the test binary is built with -cover.
+}
+
+// testBinaryFlags are the flags of 'go test' that are passed through to the test binary, with
+// a 'test.' prefix.
+var testBinaryFlags = []string{
+	"bench", "benchmem", "benchtime", "blockprofile", "blockprofilerate", "count",
+	"cpu", "cpuprofile", "failfast", "fullpath", "fuzz", "fuzzcachedir", "fuzzminimizetime",
+	"fuzztime", "fuzzworker", "gocoverdir", "list", "memprofile", "memprofilerate", "mutexprofile",
+	"mutexprofilefraction", "outputdir", "paniconexit0", "parallel", "run", "short", "shuffle",
+	"skip", "testlogfile", "timeout", "trace", "v",
+}
+
+// parseTestFlags parses the arguments of 'gd test', relative output paths are resolved against
+// root, the directory of the module's go.mod, like the other paths of the project.
+func parseTestFlags(root string, args []string) (testFlags, error) {
+	var flags testFlags
+	for i := 0; i < len(args); i++ {
+		name, value, hasValue := strings.Cut(strings.TrimLeft(args[i], "-"), "=")
+		if !strings.HasPrefix(args[i], "-") {
+			flags.run = append(flags.run, args[i])
+			continue
+		}
+		next := func() (string, error) {
+			if hasValue {
+				return value, nil
+			}
+			if i+1 == len(args) {
+				return "", fmt.Errorf("gd: flag -%s needs a value", name)
+			}
+			i++
+			return args[i], nil
+		}
+		switch name {
+		case "json":
+			flags.json = true
+		case "junit":
+			path, err := next()
+			if err != nil {
+				return flags, err
+			}
+			flags.junit = inModule(root, path)
+		case "cover":
+			flags.coverage = true
+		case "covermode", "coverpkg":
+			setting, err := next()
+			if err != nil {
+				return flags, err
+			}
+			flags.coverage = true
+			flags.build = append(flags.build, "-"+name+"="+setting)
+		case "coverprofile":
+			path, err := next()
+			if err != nil {
+				return flags, err
+			}
+			// the engine runs in the graphics directory, so the profile is written back to
+			// the module directory.
+			flags.coverage = true
+			flags.run = append(flags.run, "-test.coverprofile="+inModule(root, path))
+		default:
+			if slices.Contains(testBinaryFlags, name) {
+				args[i] = "-test." + strings.TrimLeft(args[i], "-")
+			}
+			flags.run = append(flags.run, args[i])
+		}
+	}
+	if flags.coverage {
+		flags.build = append(flags.build, "-cover")
+	}
+	return flags, nil
+}
+
+// inModule returns path, resolved against the module directory root, unless it is absolute.
+func inModule(root, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(root, path)
+}
+
+// runTests runs the test binary inside a headless engine, the result is determined by the exit
+// status of the engine (which exits with the status of the test binary), along with any tests
+// reported as failing in its output.
+func runTests(godot, graphics string, flags testFlags) error {
+	args := append([]string{"--headless"}, flags.run...)
+	if flags.coverage && !slices.ContainsFunc(flags.run, func(arg string) bool {
+		return strings.HasPrefix(arg, "-test.gocoverdir")
+	}) {
+		// counters are written here, before being merged into the -coverprofile.
+		dir, err := os.MkdirTemp("", "gd-cover-")
+		if err != nil {
+			return xray.New(err)
+		}
+		defer os.RemoveAll(dir)
+		args = append(args, "-test.gocoverdir="+dir)
+	}
+	structured := flags.json || flags.junit != ""
+	var engine *exec.Cmd
+	if structured {
+		pkg, err := exec.Command("go", "list", ".").Output()
+		if err != nil {
+			return xray.New(err)
+		}
+		// test2json runs the engine, so that its final pass or fail event reflects the exit status.
+		args = append([]string{"tool", "test2json", "-t", "-p", strings.TrimSpace(string(pkg)), godot}, append(args, "-test.v=test2json")...)
+		engine = exec.Command("go", args...)
+	} else {
+		engine = exec.Command(godot, args...)
+	}
+	engine.Dir = graphics
+	engine.Stderr = os.Stderr
+	engine.Stdin = os.Stdin
+	output, err := engine.StdoutPipe()
+	if err != nil {
+		return xray.New(err)
+	}
+	if err := engine.Start(); err != nil {
+		return xray.New(err)
+	}
+	var report *testReport
+	if structured {
+		report = readTestEvents(output, os.Stdout, flags.json)
+	} else {
+		report = readTestOutput(output, os.Stdout)
+	}
+	engineErr := engine.Wait()
+	if !structured {
+		report.passed = engineErr == nil && !report.failed
+	}
+	if flags.junit != "" {
+		if err := writeJUnit(flags.junit, report); err != nil {
+			return err
+		}
+	}
+	switch {
+	case report.failed:
+		return errors.New("gd: tests failed")
+	case !report.passed && engineErr != nil:
+		return fmt.Errorf("gd: the engine exited before the tests completed: %w", engineErr)
+	case !report.passed:
+		return errors.New("gd: the engine exited before the tests completed")
+	}
+	return nil
+}
+
+// testReport is the result of running the test binary.
+type testReport struct {
+	pkg     string
+	passed  bool // the test binary exited successfully.
+	failed  bool // a test, or the test binary, failed.
+	elapsed float64
+	tests   []*testResult
+}
+
+type testResult struct {
+	name    string
+	action  string // pass, fail or skip.
+	elapsed float64
+	output  strings.Builder
+}
+
+// readTestOutput copies the plain output of the test binary to w, whilst looking for any
+// failures, whether it passed is determined by its exit status.
+func readTestOutput(r io.Reader, w io.Writer) *testReport {
+	report := new(testReport)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		line := scanner.Text()
+		fmt.Fprintln(w, line)
+		if line == "FAIL" || strings.HasPrefix(line, "--- FAIL") {
+			report.failed = true
+		}
+	}
+	return report
+}
+
+// testEvent is the JSON output of test2json, see 'go doc test2json'.
+type testEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// readTestEvents reads the test2json events from r, copying them (or if raw is false, their
+// output) to w.
+func readTestEvents(r io.Reader, w io.Writer, raw bool) *testReport {
+	report := new(testReport)
+	tests := make(map[string]*testResult)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		var event testEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			fmt.Fprintln(w, scanner.Text())
+			continue
+		}
+		if raw {
+			fmt.Fprintln(w, scanner.Text())
+		} else {
+			fmt.Fprint(w, event.Output)
+		}
+		report.pkg = event.Package
+		if event.Test == "" {
+			switch event.Action {
+			case "fail":
+				report.failed = true
+				report.elapsed = event.Elapsed
+			case "pass":
+				report.passed = true
+				report.elapsed = event.Elapsed
+			}
+			continue
+		}
+		test, ok := tests[event.Test]
+		if !ok {
+			test = &testResult{name: event.Test}
+			tests[event.Test] = test
+			report.tests = append(report.tests, test)
+		}
+		switch event.Action {
+		case "output":
+			test.output.WriteString(event.Output)
+		case "fail":
+			report.failed = true
+			fallthrough
+		case "pass", "skip":
+			test.action = event.Action
+			test.elapsed = event.Elapsed
+		}
+	}
+	return report
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Classname string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// writeJUnit writes the report to path as JUnit XML, for CI systems.
+func writeJUnit(path string, report *testReport) error {
+	suite := junitTestSuite{Name: report.pkg, Time: fmt.Sprintf("%.3f", report.elapsed)}
+	for _, test := range report.tests {
+		testCase := junitTestCase{
+			Classname: report.pkg,
+			Name:      test.name,
+			Time:      fmt.Sprintf("%.3f", test.elapsed),
+		}
+		switch test.action {
+		case "fail":
+			suite.Failures++
+			testCase.Failure = &junitMessage{Message: "Failed", Body: test.output.String()}
+		case "skip":
+			suite.Skipped++
+			testCase.Skipped = &junitMessage{Message: "Skipped", Body: test.output.String()}
+		case "":
+			suite.Errors++
+			testCase.Error = &junitMessage{Message: "the engine exited before the test completed", Body: test.output.String()}
+		}
+		suite.Cases = append(suite.Cases, testCase)
+	}
+	if !report.passed && !report.failed && len(report.tests) == 0 {
+		suite.Errors++
+		suite.Cases = append(suite.Cases, junitTestCase{
+			Classname: report.pkg,
+			Name:      "TestMain",
+			Time:      suite.Time,
+			Error:     &junitMessage{Message: "the engine exited before the tests completed"},
+		})
+	}
+	suite.Tests = len(suite.Cases)
+	data, err := xml.MarshalIndent(junitTestSuites{Suites: []junitTestSuite{suite}}, "", "\t")
+	if err != nil {
+		return xray.New(err)
+	}
+	return xray.New(os.WriteFile(path, append([]byte(xml.Header), append(data, '\n')...), 0o644))
+}
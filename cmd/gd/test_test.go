@@ -0,0 +1,134 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseTestFlags(t *testing.T) {
+	root := t.TempDir()
+	flags, err := parseTestFlags(root, []string{"-v", "-run=TestX", "-count", "2", "-json", "-coverprofile", "cover.out", "-coverpkg=./...", "-junit=report.xml"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"-test.v", "-test.run=TestX", "-test.count", "2", "-test.coverprofile=" + filepath.Join(root, "cover.out")}; !slices.Equal(flags.run, expected) {
+		t.Fatalf("unexpected run flags %q", flags.run)
+	}
+	if expected := []string{"-coverpkg=./...", "-cover"}; !slices.Equal(flags.build, expected) {
+		t.Fatalf("unexpected build flags %q", flags.build)
+	}
+	if !flags.json || !flags.coverage || flags.junit != filepath.Join(root, "report.xml") {
+		t.Fatalf("unexpected flags %+v", flags)
+	}
+	if _, err := parseTestFlags(root, []string{"-junit"}); err == nil {
+		t.Fatal("expected an error for a missing value")
+	}
+	// absolute paths are left as they are, wherever gd test is run from.
+	absolute := filepath.Join(t.TempDir(), "report.xml")
+	if flags, err := parseTestFlags(root, []string{"-junit", absolute, "-coverprofile=" + absolute}); err != nil || flags.junit != absolute || flags.run[0] != "-test.coverprofile="+absolute {
+		t.Fatalf("unexpected flags %+v (%v)", flags, err)
+	}
+}
+
+func TestReadTestOutput(t *testing.T) {
+	var out strings.Builder
+	report := readTestOutput(strings.NewReader("Godot Engine\n--- FAIL: TestX (0.00s)\nFAIL\n"), &out)
+	if !report.failed || report.passed || out.String() != "Godot Engine\n--- FAIL: TestX (0.00s)\nFAIL\n" {
+		t.Fatalf("unexpected report %+v\n%s", report, out.String())
+	}
+	// whether the tests passed is left to the exit status.
+	report = readTestOutput(strings.NewReader("Godot Engine\n=== RUN   TestX\n"), &out)
+	if report.failed || report.passed {
+		t.Fatalf("unexpected report %+v", report)
+	}
+}
+
+// TestRunTestsList runs a fake engine that lists the tests, as the test binary does for
+// -test.list, which prints no PASS line, so the exit status decides the result.
+func TestRunTestsList(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("requires a shell")
+	}
+	dir := t.TempDir()
+	engine := func(script string) string {
+		path := filepath.Join(dir, "godot")
+		if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	flags, err := parseTestFlags(dir, []string{"-list", "."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(flags.run, "-test.list") {
+		t.Fatalf("expected -list to be passed to the test binary, got %q", flags.run)
+	}
+	list := engine(`printf 'TestPlayerMove\nTestLevel\n'`)
+	if err := runTests(list, dir, flags); err != nil {
+		t.Fatalf("expected the listing to succeed, got %v", err)
+	}
+	flags.junit = filepath.Join(dir, "junit.xml")
+	if err := runTests(list, dir, flags); err != nil {
+		t.Fatalf("expected the structured listing to succeed, got %v", err)
+	}
+	crash := engine(`echo TestPlayerMove; exit 2`)
+	if err := runTests(crash, dir, flags); err == nil {
+		t.Fatal("expected a failing exit status to fail the structured run")
+	}
+	flags.junit = ""
+	if err := runTests(crash, dir, flags); err == nil {
+		t.Fatal("expected a failing exit status to fail the run")
+	}
+}
+
+const testEvents = `{"Action":"start","Package":"example.com/game"}
+{"Action":"run","Package":"example.com/game","Test":"TestPass"}
+{"Action":"output","Package":"example.com/game","Test":"TestPass","Output":"=== RUN   TestPass\n"}
+{"Action":"pass","Package":"example.com/game","Test":"TestPass","Elapsed":0.5}
+{"Action":"run","Package":"example.com/game","Test":"TestFail"}
+{"Action":"output","Package":"example.com/game","Test":"TestFail","Output":"    main_test.go:10: <oops>\n"}
+{"Action":"fail","Package":"example.com/game","Test":"TestFail","Elapsed":0.25}
+{"Action":"run","Package":"example.com/game","Test":"TestSkip"}
+{"Action":"skip","Package":"example.com/game","Test":"TestSkip"}
+{"Action":"output","Package":"example.com/game","Output":"FAIL\n"}
+{"Action":"fail","Package":"example.com/game","Elapsed":1}
+`
+
+func TestReadTestEvents(t *testing.T) {
+	var out strings.Builder
+	readTestEvents(strings.NewReader(testEvents), &out, true)
+	if out.String() != testEvents {
+		t.Fatalf("expected the events to be passed through, got:\n%s", out.String())
+	}
+	out.Reset()
+	report := readTestEvents(strings.NewReader(testEvents), &out, false)
+	if out.String() != "=== RUN   TestPass\n    main_test.go:10: <oops>\nFAIL\n" {
+		t.Fatalf("unexpected output:\n%s", out.String())
+	}
+	if !report.failed || report.passed || len(report.tests) != 3 || report.pkg != "example.com/game" {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	path := filepath.Join(t.TempDir(), "junit.xml")
+	if err := writeJUnit(path, report); err != nil {
+		t.Fatal(err)
+	}
+	junit, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`<testsuite name="example.com/game" tests="3" failures="1" errors="0" skipped="1" time="1.000">`,
+		`<testcase classname="example.com/game" name="TestPass" time="0.500"></testcase>`,
+		`<failure message="Failed">    main_test.go:10: &lt;oops&gt;`,
+		`<skipped message="Skipped"></skipped>`,
+	} {
+		if !strings.Contains(string(junit), expected) {
+			t.Fatalf("expected %s in:\n%s", expected, junit)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"graphics.gd/internal/sources"
+)
+
+const (
+	watchInterval = 250 * time.Millisecond // between checks for changes.
+	watchDebounce = 300 * time.Millisecond // to wait for any further changes, ie. from saving multiple files.
+)
+
+// watchProject implements 'gd run -watch', running the engine and then rebuilding the library
+// and restarting the engine whenever the Go files of the module at root change. If the
+// library failed to build, the engine is only started after the next successful build. The
+// go command's build cache is reused between builds, so only the changed packages are rebuilt.
+// Watching stops, along with the engine, once ctx is done.
+func watchProject(ctx context.Context, root string, built bool, build func() error, command func() *exec.Cmd) error {
+	var (
+		running *exec.Cmd
+		exited  chan struct{} // closed when running exits, nil when nothing is running.
+	)
+	start := func() {
+		cmd := command()
+		if err := cmd.Start(); err != nil {
+			fmt.Fprintln(os.Stderr, "gd:", err)
+			return
+		}
+		done := make(chan struct{})
+		go func() {
+			cmd.Wait()
+			close(done)
+		}()
+		running, exited = cmd, done
+	}
+	stop := func() {
+		if running == nil {
+			return
+		}
+		if err := running.Process.Signal(os.Interrupt); err != nil {
+			running.Process.Kill()
+		}
+		select {
+		case <-exited:
+		case <-time.After(3 * time.Second):
+			running.Process.Kill()
+			<-exited
+		}
+		running, exited = nil, nil
+	}
+	defer stop()
+	if built {
+		start()
+	} else {
+		fmt.Println("gd: build failed, waiting for changes...")
+	}
+	last := sources.Scan(root)
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-exited:
+			running, exited = nil, nil
+			fmt.Println("gd: the engine exited, waiting for changes...")
+			continue
+		case <-ticker.C:
+		}
+		latest := sources.Scan(root)
+		if latest == last || time.Since(latest.Latest) < watchDebounce {
+			continue
+		}
+		last = latest
+		fmt.Println("gd: rebuilding...")
+		if runtime.GOOS == "windows" {
+			stop() // the library cannot be replaced while it is loaded.
+		}
+		began := time.Now()
+		if err := build(); err != nil {
+			fmt.Printf("gd: build failed in %.1fs, waiting for changes...\n", time.Since(began).Seconds())
+			continue
+		}
+		stop()
+		fmt.Printf("gd: rebuilt in %.1fs, restarting\n", time.Since(began).Seconds())
+		start()
+	}
+}
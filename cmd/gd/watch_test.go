@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestWatchProject(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sleep")
+	}
+	root := t.TempDir()
+	source := filepath.Join(root, "main.go")
+	if err := os.WriteFile(source, []byte("package main\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	epoch := time.Now().Add(-time.Hour)
+	touch := func(at time.Time) {
+		t.Helper()
+		if err := os.Chtimes(source, at, at); err != nil {
+			t.Fatal(err)
+		}
+	}
+	touch(epoch)
+	var (
+		mutex    sync.Mutex
+		builds   int
+		failing  bool
+		commands []*exec.Cmd
+	)
+	build := func() error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		builds++
+		if failing {
+			return errors.New("build failed")
+		}
+		return nil
+	}
+	command := func() *exec.Cmd {
+		mutex.Lock()
+		defer mutex.Unlock()
+		cmd := exec.Command("sleep", "60")
+		commands = append(commands, cmd)
+		return cmd
+	}
+	state := func() (int, int) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return builds, len(commands)
+	}
+	await := func(expectedBuilds, expectedCommands int) {
+		t.Helper()
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+			if b, c := state(); b == expectedBuilds && c == expectedCommands {
+				return
+			}
+		}
+		b, c := state()
+		t.Fatalf("expected %d builds and %d engines, got %d and %d", expectedBuilds, expectedCommands, b, c)
+	}
+	running := func(i int) bool {
+		mutex.Lock()
+		cmd := commands[i]
+		mutex.Unlock()
+		return cmd.Process.Signal(syscall.Signal(0)) == nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- watchProject(ctx, root, false, build, command) }()
+
+	// nothing runs until the first successful build.
+	time.Sleep(2 * watchInterval)
+	await(0, 0)
+	touch(epoch.Add(time.Second))
+	await(1, 1)
+
+	// recent changes are debounced.
+	recent := time.Now()
+	touch(recent)
+	time.Sleep(watchInterval - 50*time.Millisecond)
+	await(1, 1)
+	await(2, 2)
+	if running(0) {
+		t.Fatal("expected the previous engine to be stopped before restarting")
+	}
+
+	// failed builds leave the engine running.
+	mutex.Lock()
+	failing = true
+	mutex.Unlock()
+	touch(recent.Add(time.Millisecond))
+	time.Sleep(2 * watchInterval)
+	await(3, 2)
+	if !running(1) {
+		t.Fatal("expected the engine to keep running after a failed build")
+	}
+
+	// removing a file rebuilds, even though no file is any newer.
+	mutex.Lock()
+	failing = false
+	mutex.Unlock()
+	if err := os.Remove(source); err != nil {
+		t.Fatal(err)
+	}
+	await(4, 3)
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected watching to stop")
+	}
+	if running(2) {
+		t.Fatal("expected the engine to be stopped")
+	}
+}
@@ -135,6 +135,7 @@ type API struct {
 	Dictionary struct {
 		Index    func(dict Dictionary, key Variant) Variant
 		SetIndex func(dict Dictionary, key, val Variant)
+		SetTyped func(self Dictionary, key VariantType, keyClassName StringName, keyScript Object, val VariantType, valClassName StringName, valScript Object)
 	}
 	Object struct {
 		MethodBindCall              func(method MethodBind, obj [1]Object, arg ...Variant) (Variant, error)
@@ -166,6 +167,7 @@ type API struct {
 
 		RegisterClass                 func(library ExtensionToken, name, extends StringName, info ClassInterface)
 		RegisterClassMethod           func(library ExtensionToken, class StringName, info Method)
+		RegisterClassVirtualMethod    func(library ExtensionToken, class StringName, info Method) // Call and PointerCall are unused.
 		RegisterClassIntegerConstant  func(library ExtensionToken, class, enum, name StringName, value int64, bitfield bool)
 		RegisterClassProperty         func(library ExtensionToken, class StringName, info PropertyInfo, getter, setter StringName)
 		RegisterClassPropertyIndexed  func(library ExtensionToken, class StringName, info PropertyInfo, getter, setter StringName, index int64)
@@ -344,6 +346,8 @@ type ClassInterface interface {
 	IsVirtual() bool
 	IsAbstract() bool
 	IsExposed() bool
+	IsRuntime() bool
+	IconPath() string
 
 	CreateInstance() [1]Object
 	GetVirtual(StringName) any
@@ -0,0 +1,75 @@
+package gd
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var (
+	errShutdown = errors.New("engine shut down")
+	errFreed    = errors.New("object freed")
+)
+
+var engineContext, cancelEngineContext = context.WithCancelCause(context.Background())
+
+// EngineContext returns a context that is cancelled when the engine shuts down.
+func EngineContext() context.Context { return engineContext }
+
+// CancelEngineContext cancels the [EngineContext] along with every object context,
+// it is called when the engine shuts down.
+func CancelEngineContext() {
+	cancelEngineContext(errShutdown)
+	objectContexts.Lock()
+	clear(objectContexts.m)
+	objectContexts.Unlock()
+}
+
+type objectContext struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+}
+
+// objectContexts are the contexts of objects, keyed by their instance ID.
+var objectContexts struct {
+	sync.Mutex
+	m map[ObjectID]objectContext
+}
+
+// ObjectContext returns the context of the object with the given ID, derived from the
+// [EngineContext]. The first time that a context is created for the object, watch is
+// called so that it can arrange for [CancelObjectContext] to be called.
+func ObjectContext(id ObjectID, watch func()) context.Context {
+	objectContexts.Lock()
+	if existing, ok := objectContexts.m[id]; ok {
+		objectContexts.Unlock()
+		return existing.ctx
+	}
+	if objectContexts.m == nil {
+		objectContexts.m = make(map[ObjectID]objectContext)
+	}
+	ctx, cancel := context.WithCancelCause(engineContext)
+	objectContexts.m[id] = objectContext{ctx, cancel}
+	objectContexts.Unlock()
+	if engineContext.Err() == nil {
+		watch()
+	}
+	return ctx
+}
+
+// CancelObjectContext cancels the context of the object with the given ID (if any) with the
+// given cause, a nil cause means that the object was freed, so any signal subscriptions to it
+// are released.
+func CancelObjectContext(id ObjectID, cause error) {
+	if cause == nil {
+		cause = errFreed
+		releaseSignalConnections(id)
+	}
+	objectContexts.Lock()
+	existing, ok := objectContexts.m[id]
+	delete(objectContexts.m, id)
+	objectContexts.Unlock()
+	if ok {
+		existing.cancel(cause)
+	}
+}
@@ -38,6 +38,9 @@ func convertVariantToDesiredGoType(value Variant, rtype reflect.Type) (reflect.V
 			}
 			object := [1]Object{LetVariantAsPointerType[Object](value, TypeObject)}
 			casted := Global.Object.CastTo(object, Global.ClassDB.GetClassTag(NewStringName(classNameOf(rtype))))
+			if casted == ([1]Object{}) && object != ([1]Object{}) {
+				return reflect.Value{}, xray.New(fmt.Errorf("cannot convert object to %s", rtype))
+			}
 			var result = reflect.New(rtype)
 			*(*[1]Object)(result.UnsafePointer()) = casted
 			return result.Elem(), nil
@@ -67,10 +70,13 @@ func VariantAs[T any](value Variant) T {
 }
 
 func ConvertToDesiredGoType(value any, rtype reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Zero(rtype), nil
+	}
 	if reflect.TypeOf(value) == rtype {
 		return reflect.ValueOf(value), nil
 	}
-	if reflect.TypeOf(value).ConvertibleTo(rtype) {
+	if convertible(reflect.TypeOf(value), rtype) {
 		return reflect.ValueOf(value).Convert(rtype), nil
 	}
 	variant, ok := value.(Variant)
@@ -83,7 +89,7 @@ func ConvertToDesiredGoType(value any, rtype reflect.Type) (reflect.Value, error
 	}
 	switch rtype.Kind() {
 	case reflect.Bool:
-		return reflect.ValueOf(value).Convert(rtype), nil
+		return reflect.Value{}, xray.New(fmt.Errorf("cannot convert %T to %s", value, rtype))
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
 		switch value := value.(type) {
 		case Int, Float:
@@ -134,8 +140,12 @@ func ConvertToDesiredGoType(value any, rtype reflect.Type) (reflect.Value, error
 		}
 	case reflect.Array:
 		if rtype.Elem().Implements(reflect.TypeOf([0]IsClass{}).Elem()) {
+			object, ok := value.(IsClass)
+			if !ok {
+				return reflect.Value{}, xray.New(fmt.Errorf("cannot convert %T to %s", value, rtype))
+			}
 			var obj = reflect.New(rtype)
-			*(*Object)(obj.UnsafePointer()) = LetVariantAsPointerType[Object](variant, TypeObject)
+			*(*[1]Object)(obj.UnsafePointer()) = object.AsObject()
 			return obj.Elem(), nil
 		}
 		val, err := convertToGoArrayOf(rtype.Elem(), value)
@@ -202,8 +212,12 @@ func ConvertToDesiredGoType(value any, rtype reflect.Type) (reflect.Value, error
 		}
 	case reflect.Struct:
 		if rtype.Implements(reflect.TypeOf([0]IsClass{}).Elem()) {
+			object, ok := value.(IsClass)
+			if !ok {
+				return reflect.Value{}, xray.New(fmt.Errorf("cannot convert %T to %s", value, rtype))
+			}
 			var obj = reflect.New(rtype)
-			*(*[1]Object)(obj.UnsafePointer()) = value.(IsClass).AsObject()
+			*(*[1]Object)(obj.UnsafePointer()) = object.AsObject()
 			return obj.Elem(), nil
 		}
 		val, err := convertToGoStruct(rtype, value)
@@ -216,34 +230,66 @@ func ConvertToDesiredGoType(value any, rtype reflect.Type) (reflect.Value, error
 	}
 }
 
+// convertible reports whether a value of type from can be converted to type into with a
+// reflect conversion, without changing its meaning (ie. an integer is not converted into
+// a string).
+func convertible(from, into reflect.Type) bool {
+	if !from.ConvertibleTo(into) {
+		return false
+	}
+	if into.Kind() == reflect.String {
+		return from.Kind() == reflect.String || (from.Kind() == reflect.Slice && from.Elem().Kind() == reflect.Uint8)
+	}
+	return true
+}
+
+// convertToGoMapEntry converts the given key or value of a dictionary into the given
+// type, returning an error rather than panicking if the result could not be stored
+// in a map of that type.
+func convertToGoMapEntry(value Variant, rtype reflect.Type) (reflect.Value, error) {
+	converted, err := convertVariantToDesiredGoType(value, rtype)
+	if err != nil {
+		return reflect.Value{}, xray.New(err)
+	}
+	if !converted.IsValid() {
+		return reflect.Zero(rtype), nil
+	}
+	if !converted.Type().AssignableTo(rtype) {
+		return reflect.Value{}, xray.New(fmt.Errorf("cannot convert %s to %s", converted.Type(), rtype))
+	}
+	return converted, nil
+}
+
 func convertToGoMap(rtype reflect.Type, value any) (reflect.Value, error) {
+	var mapValue = reflect.MakeMap(rtype)
+	set := func(key, val Variant) error {
+		keyValue, err := convertToGoMapEntry(key, rtype.Key())
+		if err != nil {
+			return fmt.Errorf("invalid key: %w", err)
+		}
+		if !keyValue.Comparable() {
+			return fmt.Errorf("invalid key: %s is not comparable", keyValue.Type())
+		}
+		valueValue, err := convertToGoMapEntry(val, rtype.Elem())
+		if err != nil {
+			return fmt.Errorf("invalid value for key %v: %w", key, err)
+		}
+		mapValue.SetMapIndex(keyValue, valueValue)
+		return nil
+	}
 	switch dictionary := value.(type) {
 	case DictionaryType.Any:
-		var mapValue = reflect.MakeMap(rtype)
 		for key, val := range dictionary.Iter() {
-			keyValue, err := convertVariantToDesiredGoType(NewVariant(key), rtype.Key())
-			if err != nil {
-				return reflect.Value{}, xray.New(err)
-			}
-			valueValue, err := convertVariantToDesiredGoType(NewVariant(val), rtype.Elem())
-			if err != nil {
+			if err := set(NewVariant(key), NewVariant(val)); err != nil {
 				return reflect.Value{}, xray.New(err)
 			}
-			mapValue.SetMapIndex(keyValue, valueValue)
 		}
 		return mapValue, nil
 	case Dictionary:
-		var mapValue = reflect.MakeMap(rtype)
 		for _, key := range dictionary.Keys().Iter() {
-			keyValue, err := convertVariantToDesiredGoType(NewVariant(key), rtype.Key())
-			if err != nil {
-				return reflect.Value{}, xray.New(err)
-			}
-			valueValue, err := convertVariantToDesiredGoType(NewVariant(dictionary.Index(key)), rtype.Elem())
-			if err != nil {
+			if err := set(NewVariant(key), NewVariant(dictionary.Index(key))); err != nil {
 				return reflect.Value{}, xray.New(err)
 			}
-			mapValue.SetMapIndex(keyValue, valueValue)
 		}
 		return mapValue, nil
 	default:
@@ -0,0 +1,155 @@
+//go:build !generate
+
+package gd_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"graphics.gd/classdb/Node"
+	"graphics.gd/classdb/SceneTree"
+	"graphics.gd/startup"
+	"graphics.gd/variant"
+	"graphics.gd/variant/Callable"
+	"graphics.gd/variant/Signal"
+)
+
+const (
+	notificationPhysicsProcess = 16
+	notificationProcess        = 17
+)
+
+// coroutineNode returns a node in the scene tree, that coroutines can be attached to.
+func coroutineNode(t *testing.T) Node.Instance {
+	node := Node.New()
+	SceneTree.Add(node)
+	t.Cleanup(func() { node.AsObject()[0].Free() })
+	return node
+}
+
+func TestCoroutineSteps(t *testing.T) {
+	node := coroutineNode(t)
+	var steps []string
+	co := startup.Go(node, func(co startup.Coroutine) {
+		steps = append(steps, "start")
+		co.Frame()
+		steps = append(steps, "frame")
+		co.Physics()
+		steps = append(steps, "physics")
+	})
+	for i, step := range []struct {
+		what   int
+		expect []string
+	}{
+		{notificationPhysicsProcess, nil}, // starts on the next process frame.
+		{notificationProcess, []string{"start"}},
+		{notificationPhysicsProcess, []string{"start"}},
+		{notificationProcess, []string{"start", "frame"}},
+		{notificationProcess, []string{"start", "frame"}},
+		{notificationPhysicsProcess, []string{"start", "frame", "physics"}},
+	} {
+		node.PropagateNotification(step.what)
+		if !slices.Equal(steps, step.expect) {
+			t.Fatalf("step %d: expected %v, got %v", i, step.expect, steps)
+		}
+	}
+	if co.Context().Err() == nil {
+		t.Fatal("expected the coroutine to stop once the behaviour returned")
+	}
+}
+
+func TestCoroutineWait(t *testing.T) {
+	node := coroutineNode(t)
+	delta := node.GetProcessDeltaTime()
+	if delta <= 0 {
+		t.Skip("no process frames have run yet")
+	}
+	var waited bool
+	startup.Go(node, func(co startup.Coroutine) {
+		co.Wait(delta * 2.5)
+		waited = true
+	})
+	for frame := 1; frame <= 3; frame++ { // the first frame starts the coroutine.
+		node.PropagateNotification(notificationProcess)
+		if waited {
+			t.Fatalf("expected to wait for 3 frames, waited for %d", frame)
+		}
+	}
+	node.PropagateNotification(notificationProcess)
+	if !waited {
+		t.Fatal("expected to be done waiting after 3 frames")
+	}
+}
+
+func TestCoroutineUntil(t *testing.T) {
+	node := coroutineNode(t)
+	var signal Signal.Any
+	signal.Attach(Callable.New(func(int) {}))
+	var received []int
+	startup.Go(node, func(co startup.Coroutine) {
+		for range 2 {
+			args := co.Until(&signal)
+			received = append(received, variant.As[int](args[0]))
+		}
+	})
+	node.PropagateNotification(notificationProcess)
+	for _, value := range []int{1, 2} {
+		signal.Emit(variant.New(value))
+		for deadline := time.Now().Add(time.Second); len(received) < value && time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+			node.PropagateNotification(notificationProcess)
+		}
+	}
+	if !slices.Equal(received, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", received)
+	}
+}
+
+func TestCoroutineStop(t *testing.T) {
+	node := coroutineNode(t)
+	var unwound, continued bool
+	co := startup.Go(node, func(co startup.Coroutine) {
+		defer func() { unwound = true }()
+		co.Stop()
+		continued = true
+	})
+	node.PropagateNotification(notificationProcess)
+	if continued || !unwound {
+		t.Fatal("expected Stop to unwind the behaviour immediately")
+	}
+	if co.Context().Err() == nil {
+		t.Fatal("expected the coroutine's context to be cancelled")
+	}
+
+	var frames int
+	co = startup.Go(node, func(co startup.Coroutine) {
+		for {
+			co.Frame()
+			frames++
+		}
+	})
+	node.PropagateNotification(notificationProcess)
+	node.PropagateNotification(notificationProcess)
+	co.Stop()
+	node.PropagateNotification(notificationProcess)
+	if frames != 1 {
+		t.Fatalf("expected the coroutine to stop after 1 frame, got %d", frames)
+	}
+}
+
+func TestCoroutineExitTree(t *testing.T) {
+	node := coroutineNode(t)
+	var unwound bool
+	co := startup.Go(node, func(co startup.Coroutine) {
+		defer func() { unwound = true }()
+		for {
+			co.Frame()
+		}
+	})
+	node.PropagateNotification(notificationProcess)
+	node.GetParent().RemoveChild(node)
+	if co.Context().Err() == nil || !unwound {
+		t.Fatal("expected the coroutine to stop once the node exited the tree")
+	}
+	SceneTree.Add(node) // so that it can be freed along with the others.
+}
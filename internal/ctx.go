@@ -34,33 +34,59 @@ var traceALL = os.Getenv("GOTRACEBACK") == "all" || os.Getenv("GOTRACEBACK") ==
 var traceSystem = os.Getenv("GOTRACEBACK") == "system"
 var traceCrash = os.Getenv("GOTRACEBACK") == "crash"
 
+// AbortOnPanic, if true, exits the process once a panic recovered by [Recover] has been
+// reported, instead of returning to the engine.
+var AbortOnPanic bool
+
+// Recover from a panic in Go code called by the engine, such that it is reported to the
+// editor's Errors panel with the file and line that it was raised from, rather than
+// crashing the engine. It must be deferred directly by each entry point from the engine.
+// Set GOTRACEBACK=crash to crash instead.
 func Recover() {
 	if !traceCrash {
 		if err := recover(); err != nil {
-			recovery(err)
+			RecoverFrom(err)
 		}
 	}
 }
 
-func recovery(err any) {
+// RecoverFrom reports a value that was recovered from a panic, see [Recover].
+func RecoverFrom(err any) {
+	function, file, line := panicked()
+	message := fmt.Sprint(err)
 	if traceALL || traceSystem {
-		Global.PrintErrorMessage("", fmt.Sprint(err, "\n", string(debug.Stack())), "gdextension.recovery", "err.go", 18, true)
-	} else {
-		name, file, line := "", "", 0
-		var buf [10]uintptr
-		for i := range runtime.Callers(0, buf[:]) {
-			pc := buf[i]
-			if pc == 0 {
-				break
-			}
-			fn := runtime.FuncForPC(pc)
-			name = fn.Name()
-			if strings.HasPrefix(name, "runtime.") || strings.HasPrefix(name, "graphics.gd") {
-				continue
+		message += "\n" + string(debug.Stack())
+	}
+	Global.PrintScriptErrorMessage("panic", message, function, file, int32(line), true)
+	if AbortOnPanic {
+		os.Exit(2)
+	}
+}
+
+// panicked returns the location that the current panic was raised from, preferring the
+// first frame outside of graphics.gd (or in a test), so that it points to the user's code.
+func panicked() (function, file string, line int) {
+	var pcs [64]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs[:])])
+	var (
+		raised   bool
+		fallback runtime.Frame
+	)
+	for {
+		frame, more := frames.Next()
+		switch {
+		case frame.Function == "runtime.gopanic":
+			raised = true
+		case !raised || strings.HasPrefix(frame.Function, "runtime."):
+		case strings.HasPrefix(frame.Function, "graphics.gd") && !strings.HasSuffix(frame.File, "_test.go"):
+			if fallback.PC == 0 {
+				fallback = frame
 			}
-			file, line = fn.FileLine(pc)
-			break
+		default:
+			return frame.Function, frame.File, frame.Line
+		}
+		if !more {
+			return fallback.Function, fallback.File, fallback.Line
 		}
-		Global.PrintErrorMessage("", fmt.Sprint(err), name, file, int32(line), true)
 	}
 }
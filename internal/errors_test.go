@@ -1,9 +1,11 @@
 package gd_test
 
 import (
+	"runtime"
 	"testing"
 
 	"graphics.gd/classdb/Expression"
+	gd "graphics.gd/internal"
 )
 
 func TestErrors(t *testing.T) {
@@ -15,3 +17,23 @@ func TestErrors(t *testing.T) {
 		t.Error("expected error")
 	}
 }
+
+func TestRecover(t *testing.T) {
+	var (
+		file string
+		line int32
+	)
+	restore := gd.Global.PrintScriptErrorMessage
+	defer func() { gd.Global.PrintScriptErrorMessage = restore }()
+	gd.Global.PrintScriptErrorMessage = func(code, message, function, f string, l int32, notifyEditor bool) {
+		file, line = f, l
+	}
+	_, expectFile, expectLine, _ := runtime.Caller(0)
+	func() {
+		defer gd.Recover()
+		panic("expected panic (reported by TestRecover)")
+	}()
+	if file != expectFile || int(line) != expectLine+3 {
+		t.Fatalf("expected the panic to be reported at %s:%d, got %s:%d", expectFile, expectLine+3, file, line)
+	}
+}
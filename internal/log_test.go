@@ -0,0 +1,20 @@
+package gd_test
+
+import (
+	"log/slog"
+	"testing"
+
+	"graphics.gd/classdb/Engine"
+)
+
+func TestLogHandler(t *testing.T) {
+	logger := slog.New(Engine.LogHandler(&slog.HandlerOptions{Level: slog.LevelDebug}))
+	logger.Debug("debug", "n", 1)
+	logger.With("player", "one").WithGroup("stats").Info("[info]", "health", 100)
+	if logger.Enabled(t.Context(), slog.LevelDebug-1) {
+		t.Fatal("expected levels below the configured level to be disabled")
+	}
+	if !slog.New(Engine.LogHandler(nil)).Enabled(t.Context(), slog.LevelInfo) {
+		t.Fatal("expected info to be enabled by default")
+	}
+}
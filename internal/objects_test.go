@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"graphics.gd/classdb/Node"
+	"graphics.gd/classdb/SceneTree"
 	"graphics.gd/internal/pointers"
 )
 
@@ -46,3 +47,17 @@ func TestAliasFreed(t *testing.T) {
 
 	alias.Name()
 }
+
+func TestNodeContext(t *testing.T) {
+	node := Node.New()
+	SceneTree.Add(node)
+	ctx := Node.Context(node)
+	if ctx.Err() != nil {
+		t.Fatal("expected context to be active while the node is in the tree")
+	}
+	node.GetParent().RemoveChild(node)
+	if ctx.Err() == nil {
+		t.Fatal("expected context to be cancelled after the node exited the tree")
+	}
+	node.AsObject()[0].Free()
+}
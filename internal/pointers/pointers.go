@@ -33,6 +33,10 @@
 //
 // The [For] function returns an iterator for all pointers of a given type, this can be used to free all pointers of a given type.
 // Up to 16 pointer types for each shape are currently supported.
+//
+// Run with GDDEBUG=pointers (or call [Trace]) to record where each pointer was allocated and
+// in which frame it was last used and expired, so that accessing an expired pointer panics
+// with these details. [Report] summarises the live and pinned pointers of each type.
 package pointers
 
 import (
@@ -131,6 +135,7 @@ var writes [shapesMax]atomic.Uint64
 // objects, only pointers allocated in the current or last cycle will
 // be preserved.
 func Cycle() {
+	traceCycle()
 	for s := range shapesMax {
 		tab := &tables[s]
 		for j := range tab.len.Load() {
@@ -148,6 +153,9 @@ func Cycle() {
 						page[i+offsetRevision].CompareAndSwap(uint64(rev), uint64(rev.expire()))
 					}
 				} else {
+					if tracing.Load() {
+						traceEnd(s, j*pageSize+i, rev, "expired")
+					}
 					jump := uintptr(page[i+offsetFreeFunc].Load())
 					if jump == 0 {
 						end(rev, s, uint64(j*pageSize+i))
@@ -271,6 +279,9 @@ func malloc[T Generic[T, P], P Size](ptr P, free func(T)) T {
 			}
 			current.revision = rev
 			current.checksum = ptr
+			if tracing.Load() {
+				traceMalloc[T](len(ptr), idx, rev)
+			}
 			return T(current)
 		}
 	}
@@ -288,6 +299,9 @@ func end(rev revision, s int, p uint64) bool {
 	}
 	if arr[addr+offsetRevision].CompareAndSwap(uint64(existing), revisionLocked) {
 		arr[addr+offsetRevision].Store(uint64(existing.close())) // next free.
+		if tracing.Load() {
+			traceEnd(s, p, existing, "ended")
+		}
 		for {
 			end := writes[s].Load()
 			arr[addr+offsetPointers].Store(end)
@@ -327,8 +341,10 @@ func Get[T Generic[T, P], P Size](ptr T) P {
 	}
 	rev := revision(arr[addr+offsetRevision].Load())
 	if !rev.matches(p.revision) {
-		fmt.Printf("%b != %b\b", rev, p.revision)
-		panic("expired pointer")
+		panic(expired(len(p.checksum), p.sentinal, p.revision))
+	}
+	if tracing.Load() {
+		traceUse(len(p.checksum), p.sentinal, p.revision)
 	}
 	if !rev.isActive() {
 		if live, ok := any(T(p)).(Liveness[P]); ok && !live.IsAlive(*(*P)(unsafe.Pointer(&ptrs))) {
@@ -412,7 +428,7 @@ func Set[T Generic[T, P], P Size](ptr T, val P) {
 	arr := tables[len(p.checksum)].Index(page)
 	rev := revision(arr[addr+offsetRevision].Load())
 	if !rev.matches(p.revision) {
-		panic("expired pointer")
+		panic(expired(len(p.checksum), p.sentinal, p.revision))
 	}
 	if arr[addr+offsetRevision].CompareAndSwap(uint64(rev), revisionLocked) {
 		var local [3]uint64
@@ -486,7 +502,7 @@ func Pin[T Generic[T, P], P Size](ptr T) T {
 	arr := tables[len(p.checksum)].Index(page)
 	rev := revision(arr[addr+offsetRevision].Load())
 	if !rev.matches(p.revision) {
-		panic("expired pointer")
+		panic(expired(len(p.checksum), p.sentinal, p.revision))
 	}
 	arr[addr+offsetRevision].CompareAndSwap(uint64(rev), uint64(rev.pinned()))
 	return ptr
@@ -533,7 +549,7 @@ func Lay[T Generic[T, P], P Size](ptr T) T {
 	arr := tables[len(p.checksum)].Index(page)
 	rev := revision(arr[addr+offsetRevision].Load())
 	if !rev.matches(p.revision) {
-		panic("expired pointer")
+		panic(expired(len(p.checksum), p.sentinal, p.revision))
 	}
 	if arr[addr+offsetRevision].CompareAndSwap(uint64(rev), revisionLocked) {
 		arr[addr+offsetFreeFunc].Store(0)
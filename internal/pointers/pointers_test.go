@@ -1,6 +1,8 @@
 package pointers_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"graphics.gd/internal/pointers"
@@ -49,3 +51,21 @@ func TestPointersV2(t *testing.T) {
 		t.Fatal("simulated pointers not freed")
 	}
 }
+
+func TestTrace(t *testing.T) {
+	pointers.Trace(true)
+	defer pointers.Trace(false)
+	ptr := pointers.New[MyPointer]([1]uint64{2})
+	pointers.Pin(pointers.New[MyPointer]([1]uint64{3}))
+	if report := pointers.Report(); !strings.Contains(report, "pointers_test.MyPointer: live=2 pinned=1") {
+		t.Fatal("unexpected report:\n" + report)
+	}
+	pointers.Cycle()
+	pointers.Cycle()
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "pointers_test.TestTrace") {
+			t.Fatalf("expected the panic to include the allocation stack, got %v", r)
+		}
+	}()
+	pointers.Get(ptr)
+}
@@ -0,0 +1,175 @@
+package pointers
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// tracing is enabled with GDDEBUG=pointers or [Trace], such that the allocation stack of each
+// pointer is recorded, along with the frames in which it was last used and expired, so that
+// these can be included in the panic raised when an expired pointer is accessed.
+var tracing atomic.Bool
+
+func init() {
+	tracing.Store(slices.Contains(strings.Split(os.Getenv("GDDEBUG"), ","), "pointers"))
+}
+
+// Trace enables or disables tracing of the pointers allocated from now on, as if the program
+// was run with GDDEBUG=pointers. Tracing is slow and should only be used for debugging.
+func Trace(enabled bool) { tracing.Store(enabled) }
+
+// frame is the number of calls to [Cycle], which is called once per frame.
+var frame atomic.Uint64
+
+// traceKey identifies an allocation, the revision distinguishes reuses of the same slot.
+type traceKey struct {
+	shape    int
+	sentinal uint64
+	revision revision
+}
+
+type trace struct {
+	name      string // of the pointer type.
+	stack     []uintptr
+	allocated uint64
+	used      uint64
+	ended     uint64
+	cause     string // of the pointer ending, when ended is non-zero.
+}
+
+var traces struct {
+	sync.Mutex
+	records map[traceKey]*trace
+}
+
+// traceKeep is the number of frames that a trace is kept for, after its pointer has ended.
+const traceKeep = 120
+
+func keyOf(shape int, sentinal uint64, rev revision) traceKey {
+	return traceKey{shape, sentinal, rev & 0x1FFFFFFFFFFFFFFF}
+}
+
+func traceMalloc[T any](shape int, sentinal uint64, rev revision) {
+	var pcs [32]uintptr
+	record := &trace{
+		name:      reflect.TypeFor[T]().String(),
+		stack:     pcs[:runtime.Callers(4, pcs[:])], // from the caller of New or Let.
+		allocated: frame.Load(),
+		used:      frame.Load(),
+	}
+	traces.Lock()
+	if traces.records == nil {
+		traces.records = make(map[traceKey]*trace)
+	}
+	traces.records[keyOf(shape, sentinal, rev)] = record
+	traces.Unlock()
+}
+
+func traceUse(shape int, sentinal uint64, rev revision) {
+	traces.Lock()
+	if record, ok := traces.records[keyOf(shape, sentinal, rev)]; ok {
+		record.used = frame.Load()
+	}
+	traces.Unlock()
+}
+
+func traceEnd(shape int, sentinal uint64, rev revision, cause string) {
+	traces.Lock()
+	if record, ok := traces.records[keyOf(shape, sentinal, rev)]; ok && record.cause == "" {
+		record.ended = frame.Load()
+		record.cause = cause
+	}
+	traces.Unlock()
+}
+
+// traceCycle advances the frame and forgets any traces that ended long enough ago.
+func traceCycle() {
+	now := frame.Add(1)
+	traces.Lock()
+	for key, record := range traces.records {
+		if record.cause != "" && now-record.ended > traceKeep {
+			delete(traces.records, key)
+		}
+	}
+	traces.Unlock()
+}
+
+// expired returns the value to panic with, when an expired pointer is accessed.
+func expired(shape int, sentinal uint64, rev revision) any {
+	if !tracing.Load() {
+		return "expired pointer"
+	}
+	traces.Lock()
+	record, ok := traces.records[keyOf(shape, sentinal, rev)]
+	traces.Unlock()
+	if !ok {
+		return fmt.Sprintf("expired pointer (untraced, or expired more than %d frames ago)", traceKeep)
+	}
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "expired pointer: %s accessed in frame %d was %s in frame %d (last used in frame %d), allocated in frame %d at:\n",
+		record.name, frame.Load(), record.cause, record.ended, record.used, record.allocated)
+	frames := runtime.CallersFrames(record.stack)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&buf, "\t%s\n\t\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return buf.String()
+}
+
+// Report returns a summary of the number of live and pinned pointers of each type, as of the
+// current frame. Pointers are grouped by their type when traced (see [Trace]), otherwise by
+// their size.
+func Report() string {
+	type count struct{ live, pinned int }
+	var counts = make(map[string]*count)
+	traces.Lock()
+	for s := range shapesMax {
+		tab := &tables[s]
+		for j := range tab.len.Load() {
+			page := tab.Index(j)
+			for i := uint64(0); i < pageSize; i += uint64(s + 2) {
+				rev := revision(page[i+offsetRevision].Load())
+				if rev == revisionEOF {
+					break
+				}
+				if rev.isClosed() || rev == revisionLocked {
+					continue
+				}
+				name := fmt.Sprintf("[%d]uint64", s)
+				if record, ok := traces.records[keyOf(s, j*pageSize+i, rev)]; ok {
+					name = record.name
+				}
+				c := counts[name]
+				if c == nil {
+					c = new(count)
+					counts[name] = c
+				}
+				c.live++
+				if rev.isPinned() {
+					c.pinned++
+				}
+			}
+		}
+	}
+	traces.Unlock()
+	var names = make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "pointers in frame %d:\n", frame.Load())
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%s: live=%d pinned=%d\n", name, counts[name].live, counts[name].pinned)
+	}
+	return buf.String()
+}
@@ -4,16 +4,29 @@ package gd_test
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	"graphics.gd/classdb"
+	"graphics.gd/classdb/ClassDB"
 	"graphics.gd/classdb/Engine"
+	"graphics.gd/classdb/GDScript"
+	"graphics.gd/classdb/MultiplayerAPI"
+	"graphics.gd/classdb/MultiplayerPeer"
 	"graphics.gd/classdb/Node"
 	"graphics.gd/classdb/Node2D"
+	"graphics.gd/classdb/Resource"
 	gd "graphics.gd/internal"
 	internal "graphics.gd/internal"
+	"graphics.gd/internal/gdclass"
+	"graphics.gd/variant/Color"
+	"graphics.gd/variant/Dictionary"
+	"graphics.gd/variant/Enum"
+	"graphics.gd/variant/Object"
 	"graphics.gd/variant/String"
 	"graphics.gd/variant/StringName"
+	"graphics.gd/variant/Vector3"
 )
 
 func TestRegister(t *testing.T) {
@@ -67,3 +80,445 @@ func TestSingleton(t *testing.T) {
 	classdb.Register[TestingSingleton]()
 	Engine.Advanced().RegisterSingleton(StringName.New("HelloWorld"), new(TestingSingleton).AsObject())
 }
+
+type TestingPropertyHints struct {
+	classdb.Extension[TestingPropertyHints, Node.Advanced]
+
+	Speed float64 `range:"0,100,0.1" suffix:"m/s"`
+	Icon  string  `hint:"file:*.png,*.svg"`
+	Notes string  `hint:"multiline"`
+	Mask  int     `hint:"layers_2d_physics"`
+	Cache []byte  `usage:"storage"`
+}
+
+func TestRegisterPropertyHints(t *testing.T) {
+	classdb.Register[TestingPropertyHints]()
+	var hints = make(map[string]ClassDB.PropertyInfo)
+	for _, info := range ClassDB.ClassGetPropertyList("TestingPropertyHints", true) {
+		hints[info.Name] = info
+	}
+	for name, expect := range map[string]ClassDB.PropertyInfo{
+		"speed": {Hint: int(classdb.PropertyHintRange), HintString: "0,100,0.1,suffix:m/s", Usage: int(classdb.PropertyUsageDefault)},
+		"icon":  {Hint: int(classdb.PropertyHintFile), HintString: "*.png,*.svg", Usage: int(classdb.PropertyUsageDefault)},
+		"notes": {Hint: int(classdb.PropertyHintMultilineText), Usage: int(classdb.PropertyUsageDefault)},
+		"mask":  {Hint: int(classdb.PropertyHintLayers2dPhysics), Usage: int(classdb.PropertyUsageDefault)},
+		"cache": {Hint: int(classdb.PropertyHintNone), Usage: int(classdb.PropertyUsageStorage)},
+	} {
+		info, ok := hints[name]
+		if !ok {
+			t.Fatalf("missing property %q", name)
+		}
+		if info.Hint != expect.Hint || info.HintString != expect.HintString || info.Usage != expect.Usage {
+			t.Fatalf("%s: expected hint %d %q usage %d, got hint %d %q usage %d", name,
+				expect.Hint, expect.HintString, expect.Usage, info.Hint, info.HintString, info.Usage)
+		}
+	}
+}
+
+type TestingPropertyGroups struct {
+	classdb.Extension[TestingPropertyGroups, Node.Advanced]
+
+	Movement struct {
+		Speed float64
+		Jump  struct {
+			Height float64
+		}
+		Dash float64 `group:"Dashing"`
+	} `group:"Movement Settings"`
+	Health int `category:"Stats"`
+}
+
+func TestRegisterPropertyGroups(t *testing.T) {
+	classdb.Register[TestingPropertyGroups]()
+	var usages = make(map[string]int)
+	for _, info := range ClassDB.ClassGetPropertyList("TestingPropertyGroups", true) {
+		usages[info.Name] = info.Usage
+	}
+	for name, usage := range map[string]int{
+		"Movement Settings":    int(classdb.PropertyUsageGroup),
+		"Jump":                 int(classdb.PropertyUsageSubgroup),
+		"movement/speed":       int(classdb.PropertyUsageDefault),
+		"movement/jump/height": int(classdb.PropertyUsageDefault),
+		"Dashing":              int(classdb.PropertyUsageSubgroup), // relative to the Movement Settings group.
+		"Stats":                int(classdb.PropertyUsageCategory),
+		"health":               int(classdb.PropertyUsageDefault),
+	} {
+		if got, ok := usages[name]; !ok || got != usage {
+			t.Fatalf("%s: expected usage %d, got %d (present=%v)", name, usage, got, ok)
+		}
+	}
+}
+
+type TestingUnexportedProperties struct {
+	classdb.Extension[TestingUnexportedProperties, Node.Advanced]
+
+	Speed  int
+	secret int
+}
+
+func TestRegisterUnexportedProperties(t *testing.T) {
+	classdb.Register[TestingUnexportedProperties]()
+	class := new(TestingUnexportedProperties)
+	defer class.Super().AsNode().QueueFree()
+	class.Speed, class.secret = 3, 4
+	object := class.AsObject()[0]
+	if speed := object.Get(gd.NewStringName("speed")); speed.Interface() != int64(3) {
+		t.Fatalf("expected speed 3, got %v", speed.Interface())
+	}
+	for _, name := range []string{"secret", "super", "Extension"} {
+		if value := object.Get(gd.NewStringName(name)); value.Type() != gd.TypeNil {
+			t.Fatalf("expected %s not to be a property, got %v", name, value.Interface())
+		}
+	}
+}
+
+type TestingPropertyDefaults struct {
+	classdb.Extension[TestingPropertyDefaults, Node.Advanced]
+
+	Offset   Vector3.XYZ `default:"Vector3(1, 2, 3)"`
+	Tint     Color.RGBA  `default:"Color(\"#ff0\")"`
+	Nickname string      `default:"Bob"`
+}
+
+func TestRegisterPropertyDefaults(t *testing.T) {
+	classdb.Register[TestingPropertyDefaults]()
+	if offset := ClassDB.ClassGetPropertyDefaultValue("TestingPropertyDefaults", "offset"); offset != (Vector3.XYZ{1, 2, 3}) {
+		t.Fatalf("expected offset Vector3(1, 2, 3), got %v", offset)
+	}
+	if tint := ClassDB.ClassGetPropertyDefaultValue("TestingPropertyDefaults", "tint"); tint != (Color.RGBA{1, 1, 0, 1}) {
+		t.Fatalf("expected tint Color(1, 1, 0, 1), got %v", tint)
+	}
+	if name := ClassDB.ClassGetPropertyDefaultValue("TestingPropertyDefaults", "nickname"); fmt.Sprint(name) != "Bob" {
+		t.Fatalf("expected nickname Bob, got %v", name)
+	}
+}
+
+type TestingInvalidResourceDefault struct {
+	classdb.Extension[TestingInvalidResourceDefault, Node.Advanced]
+
+	Theme Resource.Instance `default:"Vector3(1, 2, 3)"`
+}
+
+func TestRegisterInvalidDefaults(t *testing.T) {
+	defer func() {
+		if err := recover(); err == nil || !strings.Contains(fmt.Sprint(err), "TestingInvalidResourceDefault.Theme") {
+			t.Fatalf("expected Register to report the invalid default tag, got %v", err)
+		}
+	}()
+	classdb.Register[TestingInvalidResourceDefault]()
+}
+
+type TestingRPC struct {
+	classdb.Extension[TestingRPC, Node.Advanced]
+}
+
+func (*TestingRPC) Shoot()        {}
+func (*TestingRPC) Reload()       {}
+func (*TestingRPC) Aim(angle int) {}
+
+func TestRegisterRPC(t *testing.T) {
+	classdb.Register[TestingRPC](map[string]classdb.RPC{
+		"Shoot":  {Mode: MultiplayerAPI.RpcModeAnyPeer, Transfer: MultiplayerPeer.TransferModeReliable, CallLocal: true},
+		"Aim":    {Mode: MultiplayerAPI.RpcModeAuthority},
+		"Reload": {Mode: MultiplayerAPI.RpcModeDisabled},
+	})
+	rpc := new(TestingRPC)
+	node := Node.Instance(rpc.Super().AsNode())
+	config := fmt.Sprint(node.GetRpcConfig())
+	if !strings.Contains(config, "shoot") || !strings.Contains(config, "aim") {
+		t.Fatalf("expected an rpc config for shoot and aim, got %v", config)
+	}
+	if strings.Contains(config, "reload") {
+		t.Fatalf("expected reload not to be an rpc, got %v", config)
+	}
+	if err := classdb.Remote(rpc, 0, (*TestingRPC).Reload); err == nil || !strings.Contains(err.Error(), "not a registered RPC") {
+		t.Fatalf("expected an error for a disabled rpc, got %v", err)
+	}
+	// outside of the scene tree, there is no multiplayer API to send the call with.
+	if err := classdb.Remote1(rpc, 0, (*TestingRPC).Aim, 45); err == nil || strings.Contains(err.Error(), "not a registered RPC") {
+		t.Fatalf("expected the engine to reject the rpc, got %v", err)
+	}
+	class, _ := gdclass.Registered.Load(reflect.TypeFor[TestingRPC]())
+	recreated := Node.New()
+	class.(interface {
+		RecreateInstance([1]gd.Object) gd.ObjectInterface
+	}).RecreateInstance(recreated.AsObject())
+	if config := fmt.Sprint(recreated.GetRpcConfig()); !strings.Contains(config, "shoot") {
+		t.Fatalf("expected a recreated instance to keep its rpc config, got %v", config)
+	}
+}
+
+type TestingVirtual struct {
+	classdb.Extension[TestingVirtual, Node.Advanced]
+}
+
+func (*TestingVirtual) OnHit(damage int) bool { return damage > 10 }
+
+func TestRegisterVirtual(t *testing.T) {
+	classdb.Register[TestingVirtual](classdb.Virtuals{(*TestingVirtual).OnHit})
+	var found bool
+	for _, method := range ClassDB.ClassGetMethodList("TestingVirtual", true) {
+		if method.Name == "_on_hit" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected _on_hit to be registered as a virtual method")
+	}
+	enemy := new(TestingVirtual)
+	enemy.AsObject()
+	if !classdb.Virtual(enemy, enemy.OnHit)(20) {
+		t.Fatalf("expected the Go implementation to be called without a script override")
+	}
+}
+
+type TestingVirtualResult struct {
+	classdb.Extension[TestingVirtualResult, Node.Advanced]
+}
+
+func (*TestingVirtualResult) Damage() int { return 1 }
+
+func TestRegisterVirtualInvalidResult(t *testing.T) {
+	classdb.Register[TestingVirtualResult](classdb.Virtuals{(*TestingVirtualResult).Damage})
+	var reported []string
+	restore := gd.Global.PrintErrorMessage
+	defer func() { gd.Global.PrintErrorMessage = restore }()
+	gd.Global.PrintErrorMessage = func(code, message, function, file string, line int32, notifyEditor bool) {
+		reported = append(reported, message)
+	}
+	script := GDScript.New().AsScript()
+	script.SetSourceCode("extends TestingVirtualResult\nfunc _damage():\n\treturn \"oops\"\n")
+	script.Reload()
+	enemy := new(TestingVirtualResult)
+	defer enemy.Super().AsNode().QueueFree()
+	Object.Instance(enemy.AsObject()).SetScript(script)
+	if damage := classdb.Virtual(enemy, enemy.Damage)(); damage != 0 {
+		t.Fatalf("expected the zero value for an invalid result, got %v", damage)
+	}
+	if len(reported) != 1 || !strings.Contains(reported[0], "_damage") {
+		t.Fatalf("expected the invalid result of _damage to be reported, got %q", reported)
+	}
+}
+
+type TestingAbstract struct {
+	classdb.Extension[TestingAbstract, Node.Advanced] `icon:"res://icon.svg"`
+	classdb.Abstract
+}
+
+func TestRegisterAbstract(t *testing.T) {
+	classdb.Register[TestingAbstract]()
+	if ClassDB.CanInstantiate("TestingAbstract") {
+		t.Fatalf("expected TestingAbstract to be abstract")
+	}
+}
+
+var postinitialized = make(map[string]int)
+
+type TestingPostinitialize struct {
+	classdb.Extension[TestingPostinitialize, Resource.Advanced]
+}
+
+func (*TestingPostinitialize) Notification(what int, reversed bool) {
+	if what == 0 { // NOTIFICATION_POSTINITIALIZE
+		postinitialized["TestingPostinitialize"]++
+	}
+}
+
+type TestingPostinitializeIcon struct {
+	classdb.Extension[TestingPostinitializeIcon, Resource.Advanced] `icon:"res://icon.svg"`
+}
+
+func (*TestingPostinitializeIcon) Notification(what int, reversed bool) {
+	if what == 0 { // NOTIFICATION_POSTINITIALIZE
+		postinitialized["TestingPostinitializeIcon"]++
+	}
+}
+
+// TestRegisterPostinitialize checks that classes receive NOTIFICATION_POSTINITIALIZE when
+// they are registered without an icon (classdb_register_extension_class2) and with one
+// (classdb_register_extension_class4).
+func TestRegisterPostinitialize(t *testing.T) {
+	classdb.Register[TestingPostinitialize]()
+	classdb.Register[TestingPostinitializeIcon]()
+	for _, class := range []string{"TestingPostinitialize", "TestingPostinitializeIcon"} {
+		if ClassDB.Instantiate(class) == nil {
+			t.Fatalf("expected %v to be instantiated", class)
+		}
+		if postinitialized[class] != 1 {
+			t.Fatalf("expected %v to be notified once after initialization, got %d", class, postinitialized[class])
+		}
+	}
+}
+
+type TestingTypedDictionary struct {
+	classdb.Extension[TestingTypedDictionary, Node.Advanced]
+
+	Scores map[string]int
+	Names  Dictionary.Map[int, string]
+	Any    map[string]any
+}
+
+func TestRegisterTypedDictionary(t *testing.T) {
+	classdb.Register[TestingTypedDictionary]()
+	var hints = make(map[string]ClassDB.PropertyInfo)
+	for _, info := range ClassDB.ClassGetPropertyList("TestingTypedDictionary", true) {
+		hints[info.Name] = info
+	}
+	for name, expect := range map[string]ClassDB.PropertyInfo{
+		"scores": {Hint: int(classdb.PropertyHintDictionaryType), HintString: "String;int"},
+		"names":  {Hint: int(classdb.PropertyHintDictionaryType), HintString: "int;String"},
+		"any":    {Hint: int(classdb.PropertyHintDictionaryType), HintString: "String;Variant"},
+	} {
+		info, ok := hints[name]
+		if !ok {
+			t.Fatalf("missing property %q", name)
+		}
+		if info.Hint != expect.Hint || info.HintString != expect.HintString {
+			t.Fatalf("%s: expected hint %d %q, got hint %d %q", name, expect.Hint, expect.HintString, info.Hint, info.HintString)
+		}
+	}
+}
+
+type TestingToolButtons struct {
+	classdb.Extension[TestingToolButtons, Node.Advanced]
+	classdb.Tool
+
+	Reset func() `button:"Reset Position,Reload"`
+}
+
+func (*TestingToolButtons) Respawn() {}
+
+func TestRegisterToolButtons(t *testing.T) {
+	classdb.Register[TestingToolButtons](map[string]classdb.ToolButton{
+		"Respawn": {Text: "Respawn Enemies"},
+	})
+	var hints = make(map[string]ClassDB.PropertyInfo)
+	for _, info := range ClassDB.ClassGetPropertyList("TestingToolButtons", true) {
+		hints[info.Name] = info
+	}
+	for name, expect := range map[string]ClassDB.PropertyInfo{
+		"reset":   {Hint: int(classdb.PropertyHintToolButton), HintString: "Reset Position,Reload", Usage: int(classdb.PropertyUsageEditor)},
+		"respawn": {Hint: int(classdb.PropertyHintToolButton), HintString: "Respawn Enemies", Usage: int(classdb.PropertyUsageEditor)},
+	} {
+		info, ok := hints[name]
+		if !ok {
+			t.Fatalf("missing property %q", name)
+		}
+		if info.Hint != expect.Hint || info.HintString != expect.HintString || info.Usage != expect.Usage {
+			t.Fatalf("%s: expected hint %d %q usage %d, got hint %d %q usage %d", name,
+				expect.Hint, expect.HintString, expect.Usage, info.Hint, info.HintString, info.Usage)
+		}
+	}
+}
+
+type TestingStaticFlags Enum.Bits[struct {
+	Fire  TestingStaticFlags
+	Water TestingStaticFlags
+}]
+
+type TestingStatics struct {
+	classdb.Extension[TestingStatics, Node.Advanced]
+
+	Flags TestingStaticFlags
+}
+
+func TestRegisterStatics(t *testing.T) {
+	classdb.Register[TestingStatics](map[string]any{
+		"MAX_LIVES": 3,
+		"greeting":  func() string { return "hello" },
+	})
+	if !ClassDB.IsClassEnumBitfield("TestingStatics", "TestingStaticFlags", true) {
+		t.Fatal("expected TestingStaticFlags to be a bitfield")
+	}
+	if lives := ClassDB.ClassGetIntegerConstant("TestingStatics", "MAX_LIVES"); lives != 3 {
+		t.Fatalf("expected MAX_LIVES to be 3, got %v", lives)
+	}
+	if greeting := ClassDB.ClassCallStatic("TestingStatics", "greeting"); fmt.Sprint(greeting) != "hello" {
+		t.Fatalf("expected greeting to be hello, got %v", greeting)
+	}
+	for _, info := range ClassDB.ClassGetPropertyList("TestingStatics", true) {
+		if info.Name == "flags" && info.Hint != int(classdb.PropertyHintFlags) {
+			t.Fatalf("expected flags hint %d, got %d", classdb.PropertyHintFlags, info.Hint)
+		}
+	}
+}
+
+var TestingStaticScore = 10
+
+type TestingInvalidConstant struct {
+	classdb.Extension[TestingInvalidConstant, Node.Advanced]
+}
+
+type TestingInvalidStatic struct {
+	classdb.Extension[TestingInvalidStatic, Node.Advanced]
+}
+
+func TestRegisterInvalidStatics(t *testing.T) {
+	expectPanic := func(name string, register func()) {
+		defer func() {
+			if err := recover(); err == nil || !strings.Contains(fmt.Sprint(err), name) {
+				t.Fatalf("expected Register to reject %v, got %v", name, err)
+			}
+		}()
+		register()
+	}
+	expectPanic("GREETING", func() { classdb.Register[TestingInvalidConstant](map[string]any{"GREETING": "hello"}) })
+	expectPanic("score", func() { classdb.Register[TestingInvalidStatic](map[string]any{"score": &TestingStaticScore}) })
+}
+
+type TestingStaticMethods struct {
+	classdb.Extension[TestingStaticMethods, Node.Advanced]
+}
+
+func TestingStaticSubtract(a, b int) int { return a - b }
+
+// TestRegisterStaticMethodArguments checks that static methods receive each argument in order,
+// as they have no receiver.
+func TestRegisterStaticMethodArguments(t *testing.T) {
+	classdb.Register[TestingStaticMethods](TestingStaticSubtract)
+	if result := ClassDB.ClassCallStatic("TestingStaticMethods", "testing_static_subtract", 10, 3); fmt.Sprint(result) != "7" {
+		t.Fatalf("expected 10 - 3 to be 7, got %v", result)
+	}
+}
+
+type TestingCheck struct {
+	classdb.Extension[TestingCheck, Node.Advanced]
+
+	Health int
+	Target interface{ Hit() }
+	Events chan int
+}
+
+func (*TestingCheck) Heal(amount int)           {}
+func (*TestingCheck) Listen(events chan string) {}
+
+func TestCheck(t *testing.T) {
+	report := classdb.Check[TestingCheck]()
+	if len(report.Errors) > 0 {
+		t.Fatal(report.Errors)
+	}
+	var skipped = make(map[string]bool)
+	for _, exports := range [][]classdb.Export{report.Properties, report.Methods, report.Signals} {
+		for _, export := range exports {
+			skipped[export.Go] = export.Skipped != ""
+		}
+	}
+	for name, expect := range map[string]bool{
+		"Health": false,
+		"Target": true,
+		"Events": true,
+		"Heal":   false,
+		"Listen": true,
+	} {
+		got, ok := skipped[name]
+		if !ok {
+			t.Fatalf("%s missing from report:\n%v", name, report)
+		}
+		if got != expect {
+			t.Fatalf("%s: expected skipped=%v, got %v:\n%v", name, expect, got, report)
+		}
+	}
+	if report.Err() == nil {
+		t.Fatal("expected an error for the skipped exports")
+	}
+}
@@ -4,6 +4,8 @@ package gd
 
 import (
 	"iter"
+	"reflect"
+	"sync"
 
 	"graphics.gd/internal/callframe"
 	"graphics.gd/internal/pointers"
@@ -82,3 +84,146 @@ func (SignalProxy) Emit(raw complex128, values ...VariantPkg.Any) {
 func (SignalProxy) Emitter(raw complex128) VariantPkg.Any {
 	return VariantPkg.New(pointers.Get(pointers.Load[Signal](raw).GetObject()))
 }
+
+// signalConnection is the callable connected by an open [SubscribeSignal] subscription.
+type signalConnection struct {
+	object   ObjectID
+	callable Callable
+}
+
+// signalConnections are the open subscriptions by object, their callables are pinned until
+// either the subscription is closed, or the object is freed (see [ReleaseSignalConnections]).
+var signalConnections struct {
+	sync.Mutex
+	m      map[ObjectID]map[*signalConnection]struct{}
+	queue  []ObjectID // objects to check for being freed, a few each frame.
+	queued map[ObjectID]bool
+}
+
+// signalChecksPerFrame is the number of objects that [ReleaseSignalConnections] checks each frame.
+const signalChecksPerFrame = 32
+
+func openSignalConnection(conn *signalConnection) {
+	signalConnections.Lock()
+	defer signalConnections.Unlock()
+	if signalConnections.m == nil {
+		signalConnections.m = make(map[ObjectID]map[*signalConnection]struct{})
+		signalConnections.queued = make(map[ObjectID]bool)
+	}
+	conns, ok := signalConnections.m[conn.object]
+	if !ok {
+		conns = make(map[*signalConnection]struct{})
+		signalConnections.m[conn.object] = conns
+	}
+	conns[conn] = struct{}{}
+	if !signalConnections.queued[conn.object] {
+		signalConnections.queued[conn.object] = true
+		signalConnections.queue = append(signalConnections.queue, conn.object)
+	}
+}
+
+// closeSignalConnection reports whether the connection was still open.
+func closeSignalConnection(conn *signalConnection) bool {
+	signalConnections.Lock()
+	defer signalConnections.Unlock()
+	conns, ok := signalConnections.m[conn.object]
+	if !ok {
+		return false
+	}
+	if _, ok := conns[conn]; !ok {
+		return false
+	}
+	delete(conns, conn)
+	if len(conns) == 0 {
+		delete(signalConnections.m, conn.object)
+	}
+	return true
+}
+
+// SubscribeSignal connects fn to the named signal of the object, the returned subscription
+// disconnects it again (unless the object has since been freed). Used by the generated
+// On* methods of each class.
+func SubscribeSignal(object Object, signal string, fn any, options ...SignalType.Option) SignalType.Subscription {
+	var (
+		conn = &signalConnection{object: ObjectID(object.GetInstanceId())}
+		sub  SignalType.Subscription
+	)
+	sub = SignalType.Subscribe(func(flags SignalType.Flags) {
+		if flags&SignalType.OneShot != 0 {
+			fn = afterCall(fn, func() { CallableType.Defer(CallableType.New(sub.Close)) })
+		}
+		conn.callable = pointers.Pin(NewCallable(fn))
+		openSignalConnection(conn)
+		object.Connect(NewStringName(signal), conn.callable, int64(flags))
+	}, func() {
+		if !closeSignalConnection(conn) {
+			return // already released, as the object was freed.
+		}
+		defer conn.callable.Free()
+		lookup := Global.Object.GetInstanceFromID(conn.object)
+		if lookup == ([1]Object{}) {
+			return
+		}
+		defer pointers.End(lookup[0])
+		name := NewStringName(signal)
+		if lookup[0].IsConnected(name, conn.callable) {
+			lookup[0].Disconnect(name, conn.callable)
+		}
+	}, options...)
+	return sub
+}
+
+// releaseSignalConnections releases the callables of any open [SubscribeSignal] subscriptions
+// to the given object, once it has been freed.
+func releaseSignalConnections(id ObjectID) {
+	signalConnections.Lock()
+	conns := signalConnections.m[id]
+	delete(signalConnections.m, id)
+	signalConnections.Unlock()
+	for conn := range conns {
+		conn.callable.Free()
+	}
+}
+
+// ReleaseSignalConnections releases the callables of any open [SubscribeSignal] subscriptions
+// to objects that have since been freed, such that subscriptions that are never closed do not
+// keep their Go functions alive forever. Subscriptions to instances of extension classes are
+// released as soon as they are freed, the other objects are checked a few at a time, such that
+// each call costs the same, no matter how many subscriptions are open. Called each frame on
+// the main thread.
+func ReleaseSignalConnections() {
+	for range signalChecksPerFrame {
+		signalConnections.Lock()
+		if len(signalConnections.queue) == 0 {
+			signalConnections.Unlock()
+			return
+		}
+		id := signalConnections.queue[0]
+		signalConnections.queue = signalConnections.queue[1:]
+		_, open := signalConnections.m[id]
+		if !open {
+			delete(signalConnections.queued, id)
+		}
+		signalConnections.Unlock()
+		if !open {
+			continue
+		}
+		if lookup := Global.Object.GetInstanceFromID(id); lookup != ([1]Object{}) {
+			pointers.End(lookup[0])
+			signalConnections.Lock()
+			signalConnections.queue = append(signalConnections.queue, id)
+			signalConnections.Unlock()
+			continue
+		}
+		releaseSignalConnections(id)
+	}
+}
+
+// afterCall wraps the Go function fn, such that then is called after each call to it.
+func afterCall(fn any, then func()) any {
+	rvalue := reflect.ValueOf(fn)
+	return reflect.MakeFunc(rvalue.Type(), func(args []reflect.Value) []reflect.Value {
+		defer then()
+		return rvalue.Call(args)
+	}).Interface()
+}
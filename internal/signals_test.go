@@ -3,10 +3,14 @@
 package gd_test
 
 import (
+	"runtime"
 	"testing"
+	"weak"
 
 	"graphics.gd/classdb"
+	"graphics.gd/classdb/Node"
 	"graphics.gd/classdb/Node2D"
+	gd "graphics.gd/internal"
 	"graphics.gd/variant/Signal"
 )
 
@@ -40,3 +44,56 @@ func TestSignals(t *testing.T) {
 	custom.TakeDamage(10)
 
 }
+
+func TestSubscribeSignal(t *testing.T) {
+	node := Node.New()
+	defer node.AsObject()[0].Free()
+	var renamed, once int
+	sub := node.OnRenamed(func() { renamed++ })
+	node.OnRenamed(func() { once++ }, Signal.OneShot)
+	node.SetName("A")
+	sub.Close()
+	node.SetName("B")
+	if renamed != 1 {
+		t.Fatalf("expected 1 rename before Close, got %d", renamed)
+	}
+	if once != 1 {
+		t.Fatalf("expected 1 rename for OneShot, got %d", once)
+	}
+}
+
+func TestSubscribeSignalReleased(t *testing.T) {
+	node := Node.New()
+	renamed := new(int)
+	released := weak.Make(renamed)
+	node.OnRenamed(func() { *renamed++ })
+	node.SetName("A")
+	node.AsObject()[0].Free()
+	for range 100 { // engine objects are checked a few at a time.
+		gd.ReleaseSignalConnections()
+	}
+	renamed = nil
+	runtime.GC()
+	if released.Value() != nil {
+		t.Fatal("expected the subscription to be released once the node was freed")
+	}
+}
+
+type SignalOwner struct {
+	classdb.Extension[SignalOwner, Node.Instance]
+}
+
+func TestSubscribeSignalReleasedOnFree(t *testing.T) {
+	classdb.Register[SignalOwner]()
+	owner := new(SignalOwner)
+	renamed := new(int)
+	released := weak.Make(renamed)
+	owner.Super().AsNode().OnRenamed(func() { *renamed++ })
+	owner.Super().AsNode().SetName("A")
+	owner.AsObject()[0].Free()
+	renamed = nil
+	runtime.GC()
+	if released.Value() != nil {
+		t.Fatal("expected the subscription to be released as soon as the extension instance was freed")
+	}
+}
@@ -0,0 +1,50 @@
+// Package sources tracks changes to the Go source files of a module, for rebuilding it when
+// they change.
+package sources
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Fingerprint of the Go source files (along with the go.mod and go.sum) of a module, which
+// changes whenever any of these files are modified, added, removed or renamed.
+type Fingerprint struct {
+	Latest time.Time // latest modification time of the files.
+	Files  int       // number of files.
+	Hash   uint64    // of the names and modification times of the files.
+}
+
+// Scan returns the [Fingerprint] of the module at root. Hidden directories and the graphics
+// directory of the project are skipped.
+func Scan(root string) Fingerprint {
+	var print Fingerprint
+	hash := fnv.New64a()
+	filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if entry.IsDir() && path != root && (strings.HasPrefix(entry.Name(), ".") || entry.Name() == "graphics") {
+			return filepath.SkipDir
+		}
+		if strings.HasSuffix(path, ".go") || entry.Name() == "go.mod" || entry.Name() == "go.sum" {
+			info, err := entry.Info()
+			if err != nil {
+				return nil
+			}
+			if info.ModTime().After(print.Latest) {
+				print.Latest = info.ModTime()
+			}
+			print.Files++
+			hash.Write([]byte(path))
+			hash.Write(binary.LittleEndian.AppendUint64([]byte{0}, uint64(info.ModTime().UnixNano())))
+		}
+		return nil
+	})
+	print.Hash = hash.Sum64()
+	return print
+}
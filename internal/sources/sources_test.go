@@ -0,0 +1,59 @@
+package sources_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"graphics.gd/internal/sources"
+)
+
+func TestScan(t *testing.T) {
+	root := t.TempDir()
+	base := time.Now().Add(-time.Hour)
+	touch := func(name string, at time.Time) {
+		t.Helper()
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, at, at); err != nil {
+			t.Fatal(err)
+		}
+	}
+	touch("main.go", base)
+	touch("go.mod", base.Add(-time.Minute))
+	// ignored.
+	touch("graphics/library.go", base.Add(time.Minute))
+	touch(".git/hooks.go", base.Add(time.Minute))
+	touch("notes.txt", base.Add(time.Minute))
+	if got := sources.Scan(root); !got.Latest.Equal(base) || got.Files != 2 {
+		t.Fatalf("expected 2 files modified at %v, got %v", base, got)
+	}
+	touch("pkg/lib.go", base.Add(time.Second))
+	if got := sources.Scan(root); !got.Latest.Equal(base.Add(time.Second)) {
+		t.Fatalf("expected the nested package to be watched, got %v", got)
+	}
+	touch("go.sum", base.Add(2*time.Second))
+	if got := sources.Scan(root); !got.Latest.Equal(base.Add(2 * time.Second)) {
+		t.Fatalf("expected go.sum to be watched, got %v", got)
+	}
+	last := sources.Scan(root)
+	if err := os.Rename(filepath.Join(root, "pkg/lib.go"), filepath.Join(root, "pkg/util.go")); err != nil {
+		t.Fatal(err)
+	}
+	renamed := sources.Scan(root)
+	if renamed == last {
+		t.Fatal("expected renaming a file to change the fingerprint")
+	}
+	if err := os.Remove(filepath.Join(root, "pkg/util.go")); err != nil {
+		t.Fatal(err)
+	}
+	if removed := sources.Scan(root); removed == renamed || removed.Files != renamed.Files-1 {
+		t.Fatalf("expected removing a file to change the fingerprint, got %v after %v", removed, renamed)
+	}
+}
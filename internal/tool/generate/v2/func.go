@@ -30,8 +30,11 @@ func (classDB ClassDB) signalCall(w io.Writer, class gdjson.Class, signal gdjson
 		}
 		fmt.Fprintf(w, "%v %v", fixReserved(arg.Name), classDB.convertTypeSimple(class, "", arg.Meta, arg.Type))
 	}
-	fmt.Fprint(w, ")) {\n\t")
-	fmt.Fprintf(w, `self[0].AsObject()[0].Connect(gd.NewStringName("%s"), gd.NewCallable(cb), 0)`, signal.Name)
+	fmt.Fprint(w, "), options ...Signal.Option) Signal.Subscription {\n\t")
+	if singleton {
+		fmt.Fprint(w, "once.Do(singleton)\n\t")
+	}
+	fmt.Fprintf(w, `return gd.SubscribeSignal(self[0].AsObject()[0], "%s", cb, options...)`, signal.Name)
 	fmt.Fprint(w, "\n}\n\n")
 }
 
@@ -160,6 +160,9 @@ func (classDB ClassDB) generateObjectPackage(class gdjson.Class, singleton bool,
 	for pkg := range gdtype.ImportsForClass(class) {
 		fmt.Fprintf(file, "import %q\n", pkg)
 	}
+	if len(class.Signals) > 0 && !class.IsEphemeral {
+		fmt.Fprintln(file, `import "graphics.gd/variant/Signal"`)
+	}
 	fmt.Fprintln(file)
 	fmt.Fprintln(file, "var _ Object.ID")
 	fmt.Fprintln(file, "type _ gdclass.Node")
@@ -416,6 +416,77 @@ func newDictionary(val reflect.Value) Dictionary {
 	return dict
 }
 
+// NewTypedDictionary converts the given Go map or [DictionaryType.Map] into a dictionary that is
+// typed by its key and value types, as expected for exported properties. The engine dictionary
+// of a [DictionaryType.Map] is typed in place whilst it is empty, or else copied, as the engine
+// can only type empty dictionaries.
+func NewTypedDictionary(val reflect.Value) Dictionary {
+	key, elem, _ := DictionaryTypesOf(val.Type())
+	if val.Kind() == reflect.Map {
+		var dict = NewDictionary()
+		setDictionaryTyped(dict, key, elem)
+		for _, k := range val.MapKeys() {
+			dict.SetIndex(NewVariant(k.Interface()), NewVariant(val.MapIndex(k).Interface()))
+		}
+		return dict
+	}
+	var dict = InternalDictionary(val.Interface().(DictionaryType.Interface).Any())
+	if dict.IsTyped() {
+		return dict
+	}
+	if dict.Size() == 0 {
+		setDictionaryTyped(dict, key, elem)
+		return dict
+	}
+	var typed = NewDictionary()
+	setDictionaryTyped(typed, key, elem)
+	typed.Assign(dict)
+	return typed
+}
+
+// DictionaryTypesOf returns the key and value types of the given Go map or [DictionaryType.Map] type.
+func DictionaryTypesOf(rtype reflect.Type) (key, val reflect.Type, ok bool) {
+	if rtype.Kind() == reflect.Map {
+		return rtype.Key(), rtype.Elem(), true
+	}
+	if rtype.Implements(reflect.TypeFor[DictionaryType.Interface]()) {
+		if index, ok := rtype.MethodByName("Index"); ok && index.Type.NumIn() == 2 && index.Type.NumOut() == 1 {
+			return index.Type.In(1), index.Type.Out(0), true
+		}
+	}
+	return nil, nil, false
+}
+
+// setDictionaryTyped makes the dictionary into a typed dictionary with the given key and value types,
+// (if they have a corresponding variant type and the engine supports typed dictionaries).
+func setDictionaryTyped(dict Dictionary, key, val reflect.Type) {
+	if Global.Dictionary.SetTyped == nil {
+		return
+	}
+	typeOf := func(rtype reflect.Type) (VariantType, bool) {
+		if rtype.Kind() == reflect.Interface {
+			return TypeNil, true
+		}
+		return VariantTypeOf(rtype)
+	}
+	ktype, ok := typeOf(key)
+	if !ok {
+		return
+	}
+	vtype, ok := typeOf(val)
+	if !ok || (ktype == TypeNil && vtype == TypeNil) {
+		return
+	}
+	var keyClassName, valClassName StringName
+	if ktype == TypeObject {
+		keyClassName = NewStringName(classNameOf(key))
+	}
+	if vtype == TypeObject {
+		valClassName = NewStringName(classNameOf(val))
+	}
+	Global.Dictionary.SetTyped(dict, ktype, keyClassName, Object{}, vtype, valClassName, Object{})
+}
+
 func newArray(val reflect.Value) Array {
 	vtype, ok := VariantTypeOf(val.Type().Elem())
 	if !ok {
@@ -1,10 +1,12 @@
 package gd_test
 
 import (
+	"reflect"
 	"testing"
 
 	gd "graphics.gd/internal"
 	"graphics.gd/variant"
+	"graphics.gd/variant/Dictionary"
 	"graphics.gd/variant/Float"
 	"graphics.gd/variant/Vector3"
 )
@@ -33,3 +35,40 @@ func TestVariants(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func TestVariantMapConversion(t *testing.T) {
+	var scores = gd.NewVariant(map[string]int{"alice": 3})
+	converted, err := gd.ConvertToDesiredGoType(scores, reflect.TypeFor[map[string]int]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if converted.Interface().(map[string]int)["alice"] != 3 {
+		t.Fatal(converted.Interface())
+	}
+	if _, err := gd.ConvertToDesiredGoType(scores, reflect.TypeFor[map[int]int]()); err == nil {
+		t.Fatal("expected an error for the wrong key type")
+	}
+}
+
+func TestVariantMapTyping(t *testing.T) {
+	scores := map[string]int{"alice": 3}
+	if gd.LetVariantAsPointerType[gd.Dictionary](gd.NewVariant(scores), gd.TypeDictionary).IsTyped() {
+		t.Fatal("expected Go maps to convert into untyped dictionaries")
+	}
+	if gd.Global.Dictionary.SetTyped == nil {
+		t.Skip("typed dictionaries are not supported by the engine")
+	}
+	typed := gd.NewTypedDictionary(reflect.ValueOf(scores))
+	if typed.GetTypedKeyBuiltin() != int64(gd.TypeString) || typed.GetTypedValueBuiltin() != int64(gd.TypeInt) {
+		t.Fatalf("expected a typed dictionary, got key %d value %d", typed.GetTypedKeyBuiltin(), typed.GetTypedValueBuiltin())
+	}
+	names := Dictionary.New[int, string]()
+	names.SetIndex(1, "one")
+	if gd.InternalDictionary(names).IsTyped() {
+		t.Fatal("expected Dictionary.Map to remain untyped outside of exported properties")
+	}
+	typed = gd.NewTypedDictionary(reflect.ValueOf(names))
+	if typed.GetTypedKeyBuiltin() != int64(gd.TypeInt) || typed.GetTypedValueBuiltin() != int64(gd.TypeString) || typed.Size() != 1 {
+		t.Fatalf("expected a typed copy, got key %d value %d", typed.GetTypedKeyBuiltin(), typed.GetTypedValueBuiltin())
+	}
+}
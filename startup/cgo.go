@@ -4,10 +4,7 @@ package startup
 
 import (
 	"errors"
-	"fmt"
-	"iter"
 	"os"
-	"runtime/debug"
 	"unsafe"
 
 	internal "graphics.gd/internal"
@@ -31,6 +28,13 @@ func init() {
 var classDB internal.ExtensionToken
 var dlsymGD func(string) unsafe.Pointer
 
+// reloader, if set, hosts the Go code of the extension in a module that can be hot-reloaded,
+// instead of running it directly (see reloads.go).
+var reloader interface {
+	initialize(level internal.GDExtensionInitializationLevel)
+	deinitialize(level internal.GDExtensionInitializationLevel)
+}
+
 func init() {
 	internal.Global = api.Import[internal.API](stub.API, "", errors.New("gdextension not linked"))
 }
@@ -50,22 +54,3 @@ func loadExtension(lookupFunc uintptr, classes, configuration unsafe.Pointer) ui
 	doInitialization(init)
 	return 1
 }
-
-//go:linkname main main.main
-func main()
-
-// call_main_in_steps calls the main function on the main thread in steps,
-// so that we can yield control back to the engine every frame and before
-// and after startup.
-func call_main_in_steps() iter.Seq[bool] {
-	return func(yield func(bool) bool) {
-		defer func() {
-			if r := recover(); r != nil {
-				fmt.Println(r)
-				debug.PrintStack()
-			}
-		}()
-		pause_main = yield
-		main()
-	}
-}
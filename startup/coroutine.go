@@ -0,0 +1,207 @@
+package startup
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
+
+	"graphics.gd/classdb"
+	"graphics.gd/classdb/Node"
+	gd "graphics.gd/internal"
+	"graphics.gd/variant"
+	"graphics.gd/variant/Float"
+	"graphics.gd/variant/Signal"
+)
+
+// Coroutine is a behaviour attached to a node with [Go], it runs linearly across frames,
+// yielding back to the engine with [Coroutine.Frame], [Coroutine.Physics], [Coroutine.Wait]
+// or [Coroutine.Until], such that behaviours which would otherwise be written as state
+// machines can be written as straight-line code.
+type Coroutine struct {
+	state *coroutine
+}
+
+type step int
+
+const (
+	stepProcess step = iota // resume on the next process frame.
+	stepPhysics             // resume on the next physics frame.
+)
+
+var errStopped = errors.New("coroutine stopped")
+
+type coroutine struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	node    *coroutineNode
+	next    func() (step, bool)
+	stop    func()
+	yield   func(step) bool
+	waiting step
+	delta   Float.X
+	running bool
+	done    bool
+}
+
+// coroutineNode is added as an internal child of the node that a [Coroutine] is attached to,
+// such that the coroutine is stepped from the node's process and physics frames, respecting
+// its process mode.
+type coroutineNode struct {
+	classdb.Extension[coroutineNode, Node.Instance] `gd:"GoCoroutine"`
+
+	state *coroutine
+}
+
+var registerCoroutine sync.Once
+
+// Go attaches the behaviour to the node as a [Coroutine], which starts on the node's next
+// process frame. The coroutine is stopped when it returns, when [Coroutine.Stop] is called,
+// or when the node exits the scene tree. Go must be called from the main thread.
+//
+//	startup.Go(guard, func(co startup.Coroutine) {
+//		for {
+//			walkTo(guard, a, co)
+//			co.Wait(2)
+//			walkTo(guard, b, co)
+//			co.Until(&alarm.Any)
+//		}
+//	})
+func Go(node Node.Instance, behaviour func(co Coroutine)) Coroutine {
+	registerCoroutine.Do(func() { classdb.Register[coroutineNode]() })
+	state := new(coroutine)
+	state.ctx, state.cancel = context.WithCancel(gd.EngineContext())
+	state.node = &coroutineNode{state: state}
+	state.next, state.stop = iter.Pull(func(yield func(step) bool) {
+		defer func() {
+			if r := recover(); r != nil && r != errStopped {
+				gd.RecoverFrom(r)
+			}
+		}()
+		state.yield = yield
+		behaviour(Coroutine{state})
+	})
+	Node.Expanded(node).AddChild(state.node.Super(), false, Node.InternalModeBack)
+	return Coroutine{state}
+}
+
+// resume the coroutine, if it is waiting for the given step.
+func (co *coroutine) resume(kind step, delta Float.X) {
+	if co.done || co.waiting != kind {
+		return
+	}
+	co.delta = delta
+	co.running = true
+	waiting, ok := co.next()
+	co.running = false
+	co.waiting = waiting
+	if !ok || co.ctx.Err() != nil {
+		co.finish()
+	}
+}
+
+// suspend the coroutine until the given step.
+func (co *coroutine) suspend(kind step) {
+	if co.ctx.Err() != nil || !co.yield(kind) {
+		panic(errStopped)
+	}
+}
+
+// finish stops the coroutine and frees its node. If the coroutine is running, then it is
+// cancelled instead, so that it unwinds the next time that it yields.
+func (co *coroutine) finish() {
+	if co.done {
+		return
+	}
+	co.cancel()
+	if co.running {
+		return
+	}
+	co.done = true
+	co.stop()
+	co.node.Super().QueueFree()
+}
+
+// Frame yields until the next process frame and returns the time elapsed since the previous
+// one, in seconds.
+func (co Coroutine) Frame() Float.X {
+	co.state.suspend(stepProcess)
+	return co.state.delta
+}
+
+// Physics yields until the next physics frame and returns the time elapsed since the previous
+// one, in seconds.
+func (co Coroutine) Physics() Float.X {
+	co.state.suspend(stepPhysics)
+	return co.state.delta
+}
+
+// Wait yields process frames until the given number of seconds have passed.
+func (co Coroutine) Wait(seconds Float.X) {
+	for elapsed := Float.X(0); elapsed < seconds; {
+		elapsed += co.Frame()
+	}
+}
+
+// Until yields process frames until the signal is next emitted and returns the emitted values.
+// For typed signals, pass their embedded [Signal.Any], ie. &player.Respawned.Any
+func (co Coroutine) Until(signal *Signal.Any) []variant.Any {
+	ctx, cancel := context.WithCancel(co.state.ctx)
+	defer cancel()
+	ch := signal.Chan(ctx)
+	for {
+		select {
+		case args, ok := <-ch:
+			if !ok && co.state.ctx.Err() != nil {
+				panic(errStopped)
+			}
+			return args
+		default:
+			co.Frame()
+		}
+	}
+}
+
+// Context returns a context that is cancelled once the coroutine stops.
+func (co Coroutine) Context() context.Context { return co.state.ctx }
+
+// Stop the coroutine, if called from within the coroutine, then the behaviour unwinds immediately,
+// otherwise it unwinds from the point at which it last yielded.
+func (co Coroutine) Stop() {
+	if co.state.running {
+		co.state.cancel()
+		panic(errStopped)
+	}
+	co.state.finish()
+}
+
+func (c *coroutineNode) Process(delta Float.X) {
+	if c.orphaned() {
+		return
+	}
+	c.state.resume(stepProcess, delta)
+}
+
+func (c *coroutineNode) PhysicsProcess(delta Float.X) {
+	if c.orphaned() {
+		return
+	}
+	c.state.resume(stepPhysics, delta)
+}
+
+func (c *coroutineNode) ExitTree() {
+	if c.orphaned() {
+		return
+	}
+	c.state.finish()
+}
+
+// orphaned reports whether the node has lost its coroutine, as happens when the Go code
+// is hot-reloaded, in which case the node frees itself.
+func (c *coroutineNode) orphaned() bool {
+	if c.state != nil {
+		return false
+	}
+	c.Super().QueueFree()
+	return true
+}
@@ -0,0 +1,170 @@
+package startup
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	gd "graphics.gd/internal"
+	"graphics.gd/variant/Signal"
+)
+
+// dispatched is the queue of functions waiting to run on the main thread.
+var dispatched struct {
+	sync.Mutex
+	queue []func()
+}
+
+// Do queues fn to run on the main thread during the next process frame. Engine APIs
+// are not safe to call from other goroutines, so Do is the way to hand work from a
+// goroutine back to the engine. Do is safe to call from any goroutine, including
+// the main thread, in which case fn still runs on the next frame.
+//
+//	go func() {
+//		data := download()
+//		startup.Do(func() {
+//			label.SetText(data)
+//		})
+//	}()
+func Do(fn func()) {
+	dispatched.Lock()
+	dispatched.queue = append(dispatched.queue, fn)
+	dispatched.Unlock()
+}
+
+// Future is the result of a function queued with [Call], it becomes available once
+// the function has run on the main thread.
+type Future[T any] struct {
+	state *future[T]
+}
+
+type future[T any] struct {
+	done  chan struct{}
+	value T
+	panic any
+}
+
+// Call queues fn to run on the main thread during the next process frame (see [Do])
+// and returns a [Future] for its result.
+//
+//	name := startup.Call(func() string {
+//		return node.Name()
+//	}).Wait()
+func Call[T any](fn func() T) Future[T] {
+	state := &future[T]{done: make(chan struct{})}
+	Do(func() {
+		defer close(state.done)
+		defer func() {
+			state.panic = recover()
+		}()
+		state.value = fn()
+	})
+	return Future[T]{state}
+}
+
+// Done returns a channel that is closed once the function has run.
+func (f Future[T]) Done() <-chan struct{} { return f.state.done }
+
+// Wait blocks until the function has run and returns its result, if the function
+// panicked, then Wait panics with the same value. Wait yields to the engine like
+// [Wait] when called from the main function stepped by [Rendering], anywhere else
+// on the main thread, Wait panics unless the function has already run, as the
+// function could never run.
+func (f Future[T]) Wait() T {
+	Wait(f.state.done)
+	if f.state.panic != nil {
+		panic(f.state.panic)
+	}
+	return f.state.value
+}
+
+// runDispatched runs the functions queued by [Do], called each process frame on the
+// main thread.
+func runDispatched() {
+	dispatched.Lock()
+	queue := dispatched.queue
+	dispatched.queue = nil
+	dispatched.Unlock()
+	for _, fn := range queue {
+		runRecovered(fn)
+	}
+}
+
+// runRecovered runs fn, reporting any panic, such that the rest of the queue still runs.
+func runRecovered(fn func()) {
+	defer func() {
+		if err := recover(); err != nil {
+			gd.RecoverFrom(err)
+		}
+	}()
+	fn()
+}
+
+// debugThreads is enabled with GDDEBUG=threads, such that any engine method, variant
+// or utility function call made off the main thread panics, instead of racing with
+// the engine.
+var debugThreads = slices.Contains(strings.Split(os.Getenv("GDDEBUG"), ","), "threads")
+
+const wrongThread = "graphics.gd: engine called off the main thread (use startup.Do to run engine calls from a goroutine)"
+
+const blockedMainThread = "graphics.gd: cannot wait on the main thread outside of the main function stepped by startup.Rendering, as the engine needs the main thread to make progress (use a goroutine, or a Signal subscription instead)"
+
+// onMainThread reports whether the current goroutine is running on the engine's main thread.
+var onMainThread = func() bool { return false }
+
+// singleThreaded is set where the engine calls into a single threaded module (see startup_wasip1.go),
+// such that the main thread is the only thread, other goroutines only run there whilst main is
+// blocked, so they must not call [Wait] until main has yielded to the engine.
+var singleThreaded bool
+
+// steppingMain is set whilst the main function stepped by the engine has control, it only hands
+// control back to the engine through pause_main, which clears it until the engine resumes main.
+var steppingMain atomic.Bool
+
+// inSteppedMain reports whether the caller is the main function stepped by the engine, as
+// the engine's main thread is locked to main whilst it has control, other goroutines cannot
+// run on it.
+func inSteppedMain() bool {
+	return pause_main != nil && steppingMain.Load() && (singleThreaded || onMainThread())
+}
+
+// Wait receives from ch, where the main function is being stepped by the engine (see [Rendering]),
+// Wait yields each frame back to the engine while it waits, whereas receiving from ch directly
+// would block the engine, such that timers and signals can be awaited linearly. The boolean
+// result is false if ch was closed. Anywhere else on the main thread (ie. inside a method called
+// by the engine), Wait panics unless ch is ready, as the engine could never make it ready.
+//
+//	for range startup.Rendering() {
+//		startup.Wait(SceneTree.After(1))
+//	}
+func Wait[T any](ch <-chan T) (T, bool) {
+	if !inSteppedMain() {
+		if onMainThread() {
+			select {
+			case value, ok := <-ch:
+				return value, ok
+			default:
+				panic(blockedMainThread)
+			}
+		}
+		value, ok := <-ch
+		return value, ok
+	}
+	for {
+		select {
+		case value, ok := <-ch:
+			return value, ok
+		default:
+			pause_main(false)
+		}
+	}
+}
+
+// block is the [Signal.Block] implementation.
+func block(ready <-chan struct{}) { Wait(ready) }
+
+func init() {
+	Signal.Block = block
+}
@@ -0,0 +1,84 @@
+package startup
+
+import (
+	"testing"
+
+	gd "graphics.gd/internal"
+)
+
+func TestRunDispatchedRecovers(t *testing.T) {
+	var reported []string
+	restore := gd.Global.PrintScriptErrorMessage
+	defer func() { gd.Global.PrintScriptErrorMessage = restore }()
+	gd.Global.PrintScriptErrorMessage = func(code, message, function, file string, line int32, notifyEditor bool) {
+		reported = append(reported, message)
+	}
+	var ran []int
+	Do(func() { ran = append(ran, 1) })
+	Do(func() { panic("oops") })
+	Do(func() { ran = append(ran, 3) })
+	runDispatched()
+	if len(ran) != 2 || ran[0] != 1 || ran[1] != 3 {
+		t.Fatalf("expected the rest of the queue to run after a panic, ran %v", ran)
+	}
+	if len(reported) != 1 || reported[0] != "oops" {
+		t.Fatalf("expected the panic to be reported, got %q", reported)
+	}
+}
+
+func TestWaitOnMainThread(t *testing.T) {
+	restore := onMainThread
+	defer func() { onMainThread = restore }()
+	onMainThread = func() bool { return true }
+	ready := make(chan int, 1)
+	ready <- 1
+	if value, ok := Wait(ready); !ok || value != 1 {
+		t.Fatalf("expected a ready channel to be received from, got %v %v", value, ok)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected waiting on the main thread to panic")
+		}
+	}()
+	Wait(make(chan int))
+}
+
+func TestFutureWaitOnMainThread(t *testing.T) {
+	restore := onMainThread
+	defer func() { onMainThread = restore }()
+	onMainThread = func() bool { return true }
+	defer func() {
+		if recover() != blockedMainThread {
+			t.Fatal("expected waiting on a future from the main thread to panic")
+		}
+	}()
+	future := Call(func() int { return 1 })
+	defer runDispatched()
+	future.Wait()
+}
+
+func TestWaitInSteppedMain(t *testing.T) {
+	restoreThread, restorePause := onMainThread, pause_main
+	defer func() { onMainThread, pause_main = restoreThread, restorePause }()
+	ready := make(chan int, 1)
+	var frames int
+	pause_main = func(bool) bool {
+		steppingMain.Store(false)
+		defer steppingMain.Store(true)
+		if frames++; frames == 3 {
+			ready <- 1
+		}
+		return true
+	}
+	steppingMain.Store(true)
+	defer steppingMain.Store(false)
+	onMainThread = func() bool { return true }
+	if value, ok := Wait(ready); !ok || value != 1 || frames != 3 {
+		t.Fatalf("expected main to yield until the channel was ready, got %v %v after %d frames", value, ok, frames)
+	}
+	onMainThread = func() bool { return false } // a goroutine, whilst main has control.
+	go func() { ready <- 2 }()
+	if value, _ := Wait(ready); value != 2 || frames != 3 {
+		t.Fatalf("expected a goroutine to receive directly, got %v after %d frames", value, frames)
+	}
+}
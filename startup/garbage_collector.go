@@ -23,12 +23,18 @@ func (goRuntime) Ready() {
 func (gr goRuntime) AsNode() NodeClass.Instance { return gr.Super().AsNode() }
 
 func (goRuntime) Process(delta Float.X) {
+	runDispatched()
 	gd.NewCallable(func() {
 		Callable.Cycle()
+		releaseSignalConnections()
 		pointers.Cycle()
 	}).CallDeferred()
 }
 
+// releaseSignalConnections is called each frame by both the scene tree and the main loop
+// (see [goMainLoop.Process]), such that subscriptions to freed objects are always released.
+var releaseSignalConnections = gd.ReleaseSignalConnections
+
 func init() {
 	gd.StartupFunctions = append(gd.StartupFunctions, func() {
 		classdb.Register[goRuntime]()
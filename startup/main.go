@@ -0,0 +1,30 @@
+//go:build cgo || wasip1
+
+package startup
+
+import (
+	"iter"
+	_ "unsafe"
+
+	gd "graphics.gd/internal"
+)
+
+//go:linkname main main.main
+func main()
+
+// call_main_in_steps calls the main function on the main thread in steps,
+// so that we can yield control back to the engine every frame and before
+// and after startup.
+func call_main_in_steps() iter.Seq[bool] {
+	return func(yield func(bool) bool) {
+		defer gd.Recover()
+		pause_main = func(done bool) bool {
+			steppingMain.Store(false)
+			defer steppingMain.Store(true)
+			return yield(done)
+		}
+		steppingMain.Store(true)
+		defer steppingMain.Store(false)
+		main()
+	}
+}
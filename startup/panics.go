@@ -0,0 +1,19 @@
+package startup
+
+import gd "graphics.gd/internal"
+
+// PanicBehaviour determines what happens when Go code called by the engine panics.
+type PanicBehaviour int
+
+const (
+	// PanicContinue reports the panic to the editor's Errors panel, with the Go file and
+	// line that it was raised from, then returns to the engine.
+	PanicContinue PanicBehaviour = iota
+	// PanicAbort reports the panic, then exits.
+	PanicAbort
+)
+
+// OnPanic configures what happens when Go code called by the engine panics, ie. in the main
+// function, a method, virtual method, callable, or property getter/setter. The default is
+// [PanicContinue]. Set GOTRACEBACK=crash to crash with a Go stack trace instead.
+func OnPanic(behaviour PanicBehaviour) { gd.AbortOnPanic = behaviour == PanicAbort }
@@ -1,80 +1,382 @@
-//go:build reloads
+//go:build reloads && cgo
 
 package startup
 
+/*
+#include <stdint.h>
+#include <string.h>
+
+extern uint64_t reload_callback(uint32_t slot, uint64_t a0, uint64_t a1, uint64_t a2, uint64_t a3, uint64_t a4, uint64_t a5);
+
+typedef uint64_t (*bridged)(uint64_t, uint64_t, uint64_t, uint64_t, uint64_t, uint64_t, uint64_t, uint64_t);
+
+// call_bridged calls the GDExtension interface function with up to 8 integer arguments,
+// any unused arguments are ignored by the callee. Functions with floating point parameters
+// cannot be called this way, so they are rejected by host_get_proc_address.
+static inline uint64_t call_bridged(uintptr_t fn, uint64_t *a) {
+	return ((bridged)fn)(a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7]);
+}
+
+static inline void copy_to_module(void *dst, uintptr_t src, size_t size) { memcpy(dst, (void *)src, size); }
+static inline void copy_from_module(uintptr_t dst, void *src, size_t size) { memcpy((void *)dst, src, size); }
+
+#define SLOT(n) static inline uint64_t slot##n(uint64_t a0, uint64_t a1, uint64_t a2, uint64_t a3, uint64_t a4, uint64_t a5) { \
+	return reload_callback(n, a0, a1, a2, a3, a4, a5); \
+}
+SLOT(0) SLOT(1) SLOT(2) SLOT(3) SLOT(4) SLOT(5) SLOT(6) SLOT(7)
+SLOT(8) SLOT(9) SLOT(10) SLOT(11) SLOT(12) SLOT(13) SLOT(14) SLOT(15)
+SLOT(16) SLOT(17) SLOT(18) SLOT(19) SLOT(20) SLOT(21) SLOT(22) SLOT(23)
+SLOT(24) SLOT(25) SLOT(26) SLOT(27) SLOT(28) SLOT(29) SLOT(30) SLOT(31)
+
+// callback_slot returns a C function pointer that dispatches to the module's callback export,
+// these stay valid across reloads, so they can be kept by the engine. The number of slots must
+// match callbackSlots.
+static inline uintptr_t callback_slot(uint32_t n) {
+	static const void *slots[32] = {
+		slot0, slot1, slot2, slot3, slot4, slot5, slot6, slot7,
+		slot8, slot9, slot10, slot11, slot12, slot13, slot14, slot15,
+		slot16, slot17, slot18, slot19, slot20, slot21, slot22, slot23,
+		slot24, slot25, slot26, slot27, slot28, slot29, slot30, slot31,
+	};
+	return n < 32 ? (uintptr_t)slots[n] : 0;
+}
+*/
+import "C"
+
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/exec"
-	"strings"
+	"path/filepath"
+	"sync"
+	"time"
+	"unsafe"
+
+	_ "embed"
 
 	"github.com/tetratelabs/wazero"
 	"github.com/tetratelabs/wazero/api"
 	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+
+	"graphics.gd/classdb"
+	EngineClass "graphics.gd/classdb/Engine"
+	SceneTreeClass "graphics.gd/classdb/SceneTree"
 	gd "graphics.gd/internal"
+	"graphics.gd/internal/sources"
 )
 
+// When built with the 'reloads' tag, the extension hosts the Go code of the project as a wasip1
+// module, which is rebuilt and swapped whenever the project's Go source files change, such that
+// changes can be tested in a running editor without restarting it. Before a swap, the module
+// saves the stored properties of each live instance of an extension class, which the new module
+// restores onto the same engine objects.
+//
+// Reloads are intended for the editor, as the main function runs again after each reload, and
+// they have the following limitations:
+//   - callables (including signal connections) created before a reload become invalid.
+//   - new classes and members are registered with the engine on reload, but registrations are
+//     never replaced or removed, as the engine cannot unregister a single member, nor a class
+//     with live instances. So renamed or removed classes and members (which are reported on
+//     reload) and changes to the signature of existing methods take effect after a restart.
+//   - coroutines (see [Go]) are stopped and goroutines only run during calls into the module.
+//   - any engine memory allocated by the previous module is leaked.
 func init() {
-	ctx := context.Background()
-	Wazero := wazero.NewRuntime(ctx)
-	wasi_snapshot_preview1.Instantiate(ctx, Wazero)
+	reloader = new(reloads)
+}
+
+type reloads struct {
+	ctx     context.Context
+	runtime wazero.Runtime
+	project string // directory of the Go module being reloaded.
+	build   string // temporary directory for builds.
+
+	module     api.Module
+	generation uint32
+	levels     []gd.GDExtensionInitializationLevel
+
+	mutex   sync.Mutex
+	pending wazero.CompiledModule // waiting to be swapped in, on the main thread.
+	stop    chan struct{}
+}
+
+// loaded is the currently hosted module, for [reload_callback].
+var loaded struct {
+	module api.Module
+	calls  []api.Function // per depth, as the engine can call back in, whilst a call is in progress.
+	lock   moduleLock
+}
+
+// enter waits until the calling thread can call into the module and returns the depth of the
+// calls into the module, must be paired with [leave].
+func enter() int { return loaded.lock.enter(currentThread()) }
+
+// leave the module, so that another thread can enter it.
+func leave() { loaded.lock.leave() }
+
+// call the named export of the module, whilst holding the module.
+func (r *reloads) call(name string, args ...uint64) ([]uint64, error) {
+	enter()
+	defer leave()
+	return r.module.ExportedFunction(name).Call(r.ctx, args...)
+}
 
+func (r *reloads) initialize(level gd.GDExtensionInitializationLevel) {
+	if r.module == nil {
+		if err := r.start(); err != nil {
+			fmt.Fprintln(os.Stderr, "graphics.gd: reloads:", err)
+			reloader = nil
+			return
+		}
+	}
+	r.levels = append(r.levels, level)
+	r.call("initialize", uint64(level))
+	if level == gd.GDExtensionInitializationLevelScene {
+		r.stop = make(chan struct{})
+		go r.watch()
+		gd.NewCallable(func() {
+			if tree, ok := classdb.As[SceneTreeClass.Instance](EngineClass.GetMainLoop()); ok {
+				tree.OnProcessFrame(r.poll)
+			}
+		}).CallDeferred()
+	}
+}
+
+func (r *reloads) deinitialize(level gd.GDExtensionInitializationLevel) {
+	if r.module == nil {
+		return
+	}
+	if level == gd.GDExtensionInitializationLevelScene && r.stop != nil {
+		close(r.stop)
+		r.stop = nil
+	}
+	r.call("deinitialize", uint64(level))
+	if level == gd.GDExtensionInitializationLevelCore {
+		enter()
+		defer leave()
+		loaded.module = nil
+		r.module.Close(r.ctx)
+		r.runtime.Close(r.ctx)
+		os.RemoveAll(r.build)
+	}
+}
+
+// start builds the project and instantiates it as the first generation of the module.
+func (r *reloads) start() error {
 	wd, err := os.Getwd()
 	if err != nil {
-		os.Stderr.WriteString(err.Error())
-		return
+		return err
+	}
+	r.ctx = context.Background()
+	r.project = wd
+	if filepath.Base(wd) == "graphics" {
+		r.project = filepath.Dir(wd)
 	}
+	r.build, err = os.MkdirTemp("", "graphics.gd-reloads-")
+	if err != nil {
+		return err
+	}
+	r.runtime = wazero.NewRuntime(r.ctx)
+	wasi_snapshot_preview1.MustInstantiate(r.ctx, r.runtime)
+	_, err = r.runtime.NewHostModuleBuilder("gdextension").
+		NewFunctionBuilder().WithFunc(host_get_proc_address).Export("get_proc_address").
+		NewFunctionBuilder().WithFunc(host_call).Export("call").
+		NewFunctionBuilder().WithFunc(host_read).Export("read").
+		NewFunctionBuilder().WithFunc(host_write).Export("write").
+		NewFunctionBuilder().WithFunc(host_callback).Export("callback").
+		Instantiate(r.ctx)
+	if err != nil {
+		return err
+	}
+	compiled, err := r.compile()
+	if err != nil {
+		return err
+	}
+	return r.instantiate(compiled, 0, 0)
+}
 
-	cmd := exec.Command("go", "build", "-o", "./graphics/library.wasm")
+// compile builds the project as a wasip1 module.
+func (r *reloads) compile() (wazero.CompiledModule, error) {
+	output := filepath.Join(r.build, "library.wasm")
+	cmd := exec.Command("go", "build", "-buildmode=c-shared", "-o", output)
 	cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm")
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
-	cmd.Dir = strings.TrimSuffix(wd, "/graphics")
+	cmd.Dir = r.project
 	if err := cmd.Run(); err != nil {
-		os.Stderr.WriteString(err.Error())
+		return nil, err
+	}
+	wasm, err := os.ReadFile(output)
+	if err != nil {
+		return nil, err
 	}
+	return r.runtime.CompileModule(r.ctx, wasm)
+}
 
-	wasm, err := os.ReadFile("./library.wasm")
+// instantiate the compiled module as the given generation and link it to the engine, state
+// is the result of the previous module's save export. The previous module is only released
+// once the new module has been linked, such that it keeps running if the new module fails.
+func (r *reloads) instantiate(compiled wazero.CompiledModule, generation uint32, state uint64) error {
+	module, err := r.runtime.InstantiateModule(r.ctx, compiled, wazero.NewModuleConfig().
+		WithName(fmt.Sprintf("library%d", generation)).
+		WithStartFunctions("_initialize").
+		WithStdout(os.Stdout).
+		WithStderr(os.Stderr).
+		WithSysWalltime().
+		WithSysNanotime().
+		WithSysNanosleep().
+		WithFSConfig(wazero.NewFSConfig().WithDirMount(r.project, "/")),
+	)
 	if err != nil {
-		os.Stderr.WriteString(err.Error())
-		return
+		return err
 	}
+	enter()
+	defer leave()
+	previous, calls := r.module, loaded.calls
+	r.module, loaded.module, loaded.calls = module, module, nil
+	if _, err := r.call("link", uint64(classDB), uint64(generation), state); err != nil {
+		module.Close(r.ctx)
+		r.module, loaded.module, loaded.calls = previous, previous, calls
+		return err
+	}
+	r.generation = generation
+	if previous != nil {
+		previous.ExportedFunction("unlink").Call(r.ctx)
+		previous.Close(r.ctx)
+	}
+	return nil
+}
 
-	var gdextension wasmRuntime
-	Wazero.NewHostModuleBuilder("gdextension").
-		NewFunctionBuilder().
-		WithGoModuleFunction(api.GoModuleFunc(gdextension.get_godot_version_major), nil, []api.ValueType{api.ValueTypeI32}).
-		Export("get_godot_version_major").
-		NewFunctionBuilder().
-		WithGoModuleFunction(api.GoModuleFunc(gdextension.get_godot_version_minor), nil, []api.ValueType{api.ValueTypeI32}).
-		Export("get_godot_version_minor").
-		NewFunctionBuilder().
-		WithGoModuleFunction(api.GoModuleFunc(gdextension.get_godot_version_patch), nil, []api.ValueType{api.ValueTypeI32}).
-		Export("get_godot_version_patch").
-		Instantiate(ctx)
-
-	gd.StartupFunctions = append(gd.StartupFunctions, func() {
-		program, err := Wazero.InstantiateWithConfig(ctx, wasm,
-			wazero.NewModuleConfig().WithStderr(os.Stderr).WithStdout(os.Stdout),
-		)
-		if err != nil {
-			os.Stderr.WriteString(err.Error())
+// watch the project's Go source files, rebuilding the module once they change.
+func (r *reloads) watch() {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	last := sources.Scan(r.project)
+	for {
+		select {
+		case <-r.stop:
 			return
+		case <-ticker.C:
+		}
+		modified := sources.Scan(r.project)
+		if modified == last || time.Since(modified.Latest) < 500*time.Millisecond {
+			continue // wait for any further changes, ie. from saving multiple files.
+		}
+		last = modified
+		fmt.Println("graphics.gd: reloading...")
+		compiled, err := r.compile()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "graphics.gd: reload failed:", err)
+			continue
+		}
+		r.mutex.Lock()
+		if r.pending != nil {
+			r.pending.Close(r.ctx)
 		}
-		program.ExportedFunction("go")
-	})
+		r.pending = compiled
+		r.mutex.Unlock()
+	}
 }
 
-type wasmRuntime struct{}
+// poll swaps in any pending module, called on the main thread each process frame.
+func (r *reloads) poll() {
+	r.mutex.Lock()
+	compiled := r.pending
+	r.pending = nil
+	r.mutex.Unlock()
+	if compiled == nil {
+		return
+	}
+	enter() // no other thread may call into either module during the swap.
+	defer leave()
+	start := time.Now()
+	results, err := r.call("save")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "graphics.gd: reload failed:", err)
+		return
+	}
+	if err := r.instantiate(compiled, r.generation+1, results[0]); err != nil {
+		fmt.Fprintln(os.Stderr, "graphics.gd: reload failed:", err)
+		return
+	}
+	for _, level := range r.levels {
+		r.call("initialize", uint64(level))
+	}
+	if _, err := r.call("restore"); err != nil {
+		fmt.Fprintln(os.Stderr, "graphics.gd: reload failed:", err)
+		return
+	}
+	fmt.Printf("graphics.gd: reloaded in %v\n", time.Since(start).Round(time.Millisecond))
+}
 
-func (wasm wasmRuntime) get_godot_version_major(ctx context.Context, m api.Module, stack []uint64) {
-	stack[0] = api.EncodeU32(gd.Global.GetGodotVersion().Major)
+//export reload_callback
+func reload_callback(slot C.uint32_t, a0, a1, a2, a3, a4, a5 C.uint64_t) C.uint64_t {
+	depth := enter() - 1
+	defer leave()
+	if loaded.module == nil {
+		return 0
+	}
+	for depth >= len(loaded.calls) {
+		loaded.calls = append(loaded.calls, loaded.module.ExportedFunction("callback"))
+	}
+	fn := loaded.calls[depth]
+	results, err := fn.Call(context.Background(), uint64(slot), uint64(a0), uint64(a1), uint64(a2), uint64(a3), uint64(a4), uint64(a5))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "graphics.gd:", err)
+		return 0
+	}
+	return C.uint64_t(results[0])
+}
+
+// gdextensionInterface is used to reject interface functions that cannot be bridged, as the
+// module links to them, rather than passing garbage arguments to them once they are called.
+//
+//go:embed gdextension_interface.h
+var gdextensionInterface string
+
+var unbridged = sync.OnceValue(func() map[string]string { return unbridgeable(gdextensionInterface) })
+
+func host_get_proc_address(ctx context.Context, m api.Module, name, length uint32) uint64 {
+	buf, ok := m.Memory().Read(name, length)
+	if !ok {
+		return 0
+	}
+	if reason, ok := unbridged()[string(buf)]; ok {
+		panic(fmt.Sprintf("graphics.gd: reloads cannot call %s, as it has %s", buf, reason))
+	}
+	return uint64(uintptr(dlsymGD(string(buf))))
+}
+
+func host_call(ctx context.Context, m api.Module, fn uint64, args, argc uint32) uint64 {
+	var a [8]uint64
+	if argc > uint32(len(a)) {
+		panic(fmt.Sprintf("graphics.gd: reloads cannot pass %d arguments to an interface function", argc))
+	}
+	for i := range argc {
+		a[i], _ = m.Memory().ReadUint64Le(args + 8*i)
+	}
+	return uint64(C.call_bridged(C.uintptr_t(fn), (*C.uint64_t)(unsafe.Pointer(&a[0]))))
 }
 
-func (wasm wasmRuntime) get_godot_version_minor(ctx context.Context, m api.Module, stack []uint64) {
-	stack[0] = api.EncodeU32(gd.Global.GetGodotVersion().Minor)
+func host_read(ctx context.Context, m api.Module, dst uint32, src uint64, size uint32) {
+	if size == 0 {
+		return
+	}
+	if buf, ok := m.Memory().Read(dst, size); ok {
+		C.copy_to_module(unsafe.Pointer(&buf[0]), C.uintptr_t(src), C.size_t(size))
+	}
+}
+
+func host_write(ctx context.Context, m api.Module, dst uint64, src, size uint32) {
+	if size == 0 {
+		return
+	}
+	if buf, ok := m.Memory().Read(src, size); ok {
+		C.copy_from_module(C.uintptr_t(dst), unsafe.Pointer(&buf[0]), C.size_t(size))
+	}
 }
 
-func (wasm wasmRuntime) get_godot_version_patch(ctx context.Context, m api.Module, stack []uint64) {
-	stack[0] = api.EncodeU32(gd.Global.GetGodotVersion().Patch)
+func host_callback(ctx context.Context, m api.Module, slot uint32) uint64 {
+	checkCallbackSlot(slot)
+	return uint64(C.callback_slot(C.uint32_t(slot)))
 }
@@ -0,0 +1,251 @@
+package startup
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	gd "graphics.gd/internal"
+	"graphics.gd/internal/pointers"
+)
+
+// The layouts shared between a reloadable module and the engine do not depend on the wasip1
+// imports, so that they can be tested on the host.
+
+// propertyInfoWords is the number of 64-bit words in a GDExtensionPropertyInfo struct.
+const propertyInfoWords = 6
+
+// propertyWords lays out the list as GDExtensionPropertyInfo structs in memory at ptr, followed
+// by the StringName and String values that they point to, 3 words per property.
+func propertyWords(ptr uint64, list []gd.PropertyInfo) []uint64 {
+	var (
+		words = make([]uint64, len(list)*(propertyInfoWords+3))
+		slots = ptr + uint64(8*propertyInfoWords*len(list))
+		info  = words[:propertyInfoWords*len(list)]
+		names = words[propertyInfoWords*len(list):]
+	)
+	for i, property := range list {
+		slot := slots + uint64(24*i)
+		info[propertyInfoWords*i+0] = uint64(property.Type)
+		info[propertyInfoWords*i+1] = slot
+		info[propertyInfoWords*i+2] = slot + 8
+		info[propertyInfoWords*i+3] = uint64(uint32(property.Hint))
+		info[propertyInfoWords*i+4] = slot + 16
+		info[propertyInfoWords*i+5] = uint64(uint32(property.Usage))
+		names[3*i+0] = uint64(pointers.Get(property.Name)[0])
+		names[3*i+1] = uint64(pointers.Get(property.ClassName)[0])
+		names[3*i+2] = uint64(pointers.Get(property.HintString)[0])
+	}
+	return words
+}
+
+// savedState is what a module saves before it is replaced by its next generation, such that
+// the next generation can restore it, see [savedState.encode] for how it is stored in the engine.
+type savedState[V any] struct {
+	registered map[string]bool
+	instances  []savedInstance[V]
+}
+
+// savedInstance is a live instance of an extension class, with its stored properties.
+type savedInstance[V any] struct {
+	ptr        uint64
+	class      string
+	properties []savedProperty[V]
+}
+
+type savedProperty[V any] struct {
+	name  string
+	value V
+}
+
+// encode the state as a list of values: the registrations that have been made (joined by
+// newlines), followed by each instance, as its engine pointer, class name and the number of
+// stored properties, followed by the name and value of each such property.
+func (state savedState[V]) encode(str func(string) V, num func(int64) V) []V {
+	values := []V{str(strings.Join(slices.Sorted(maps.Keys(state.registered)), "\n"))}
+	for _, instance := range state.instances {
+		values = append(values, num(int64(instance.ptr)), str(instance.class), num(int64(len(instance.properties))))
+		for _, property := range instance.properties {
+			values = append(values, str(property.name), property.value)
+		}
+	}
+	return values
+}
+
+// decodeState decodes the values produced by [savedState.encode], any truncated instance is
+// dropped.
+func decodeState[V any](values []V, str func(V) string, num func(V) int64) savedState[V] {
+	state := savedState[V]{registered: make(map[string]bool)}
+	if len(values) == 0 {
+		return state
+	}
+	for registration := range strings.SplitSeq(str(values[0]), "\n") {
+		if registration != "" {
+			state.registered[registration] = true
+		}
+	}
+	for i := 1; i+2 < len(values); {
+		instance := savedInstance[V]{ptr: uint64(num(values[i])), class: str(values[i+1])}
+		count := int(num(values[i+2]))
+		i += 3
+		if count < 0 || i+2*count > len(values) {
+			break
+		}
+		for ; count > 0; count-- {
+			instance.properties = append(instance.properties, savedProperty[V]{str(values[i]), values[i+1]})
+			i += 2
+		}
+		state.instances = append(state.instances, instance)
+	}
+	return state
+}
+
+// unbridgeable returns the interface functions declared in the given gdextension_interface.h that
+// the host cannot call on behalf of a module, along with the reason why. The host passes up to 8
+// arguments in integer registers, so floating point parameters (which are passed in separate
+// registers) and any further parameters would be received as garbage.
+func unbridgeable(header string) map[string]string {
+	var (
+		name   string
+		result = make(map[string]string)
+	)
+	for line := range strings.Lines(header) {
+		line = strings.TrimSpace(line)
+		if after, ok := strings.CutPrefix(line, "* @name "); ok {
+			name = strings.TrimSpace(after)
+			continue
+		}
+		if name == "" || !strings.HasPrefix(line, "typedef ") || !strings.Contains(line, "(*GDExtensionInterface") {
+			continue
+		}
+		_, params, _ := strings.Cut(line, ")(")
+		params, _, _ = strings.Cut(params, ");")
+		var list []string
+		if params = strings.TrimSpace(params); params != "" && params != "void" {
+			list = strings.Split(params, ",")
+		}
+		if len(list) > 8 {
+			result[name] = fmt.Sprintf("%d parameters", len(list))
+		}
+		for _, param := range list {
+			if strings.Contains(param, "*") {
+				continue
+			}
+			for _, word := range strings.Fields(param) {
+				if word == "float" || word == "double" || word == "real_t" {
+					result[name] = "a floating point parameter"
+				}
+			}
+		}
+		name = ""
+	}
+	return result
+}
+
+// callbackSlots is the number of callback trampolines in the host, see callback_slot in reloads.go.
+const callbackSlots = 32
+
+// slots for the callbacks that the host dispatches to the module's callback export.
+const (
+	slotSet uint32 = iota
+	slotGet
+	slotGetPropertyList
+	slotFreePropertyList
+	slotPropertyCanRevert
+	slotPropertyGetRevert
+	slotNotification
+	slotToString
+	slotReference
+	slotUnreference
+	slotCreateInstance
+	slotFreeInstance
+	slotGetVirtualCallData
+	slotCallVirtualWithData
+	slotGetRID
+	slotMethodCall
+	slotMethodPointerCall
+	slotCallableCall
+
+	// the table of trampolines in the host is fixed in size, this fails to compile once
+	// there are more slots than trampolines.
+	_ = callbackSlots - 1 - slotCallableCall
+)
+
+// checkCallbackSlot panics if the host has no trampoline for the slot, rather than handing
+// the engine a nil function pointer to call later.
+func checkCallbackSlot(slot uint32) {
+	if slot >= callbackSlots {
+		panic(fmt.Sprintf("graphics.gd: reloads has no callback slot %d, the host only has %d", slot, callbackSlots))
+	}
+}
+
+// moduleLock lets a single thread at a time call into the module, the engine calls back in
+// from worker threads (ie. threaded process groups and background loading), so they wait for
+// their turn, whilst the thread that holds the lock can re-enter it.
+type moduleLock struct {
+	mutex sync.Mutex
+	idle  sync.Cond
+	owner uintptr
+	depth int
+}
+
+// enter waits until the thread can call into the module and returns the resulting depth of
+// the calls into the module, must be paired with [moduleLock.leave].
+func (lock *moduleLock) enter(thread uintptr) int {
+	lock.mutex.Lock()
+	defer lock.mutex.Unlock()
+	if lock.idle.L == nil {
+		lock.idle.L = &lock.mutex
+	}
+	for lock.depth > 0 && lock.owner != thread {
+		lock.idle.Wait()
+	}
+	lock.owner = thread
+	lock.depth++
+	return lock.depth
+}
+
+// leave the module, so that another thread can enter it.
+func (lock *moduleLock) leave() {
+	lock.mutex.Lock()
+	defer lock.mutex.Unlock()
+	lock.depth--
+	if lock.depth == 0 {
+		lock.idle.Broadcast()
+	}
+}
+
+// frameStack is the engine memory that a module passes values on by pointer, it is used by
+// nested frames, as the engine can call back into the module whilst a call is in progress.
+type frameStack struct {
+	base uint64
+	used uint64
+	size uint64
+}
+
+// alloc reserves size bytes on the stack, aligned to 16 bytes, or reports false if they do
+// not fit.
+func (stack *frameStack) alloc(size uint64) (uint64, bool) {
+	size = (size + 15) &^ 15
+	if stack.used+size > stack.size {
+		return 0, false
+	}
+	ptr := stack.base + stack.used
+	stack.used += size
+	return ptr, true
+}
+
+// unregistered returns the registrations made by a previous generation of the module that the
+// current generation no longer makes, these stay registered with the engine until it restarts.
+func unregistered(previous, current map[string]bool) []string {
+	var stale []string
+	for registration := range previous {
+		if !current[registration] {
+			stale = append(stale, registration)
+		}
+	}
+	slices.Sort(stale)
+	return stale
+}
@@ -0,0 +1,209 @@
+package startup
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	gd "graphics.gd/internal"
+	"graphics.gd/internal/pointers"
+)
+
+func TestPropertyWords(t *testing.T) {
+	name := func(ptr uintptr) gd.StringName {
+		return pointers.Raw[gd.StringName]([1]gd.EnginePointer{gd.EnginePointer(ptr)})
+	}
+	list := []gd.PropertyInfo{
+		{Type: gd.TypeInt, Name: name(0xA1), ClassName: name(0xA2), Hint: 1, HintString: pointers.Raw[gd.String]([1]gd.EnginePointer{0xA3}), Usage: 6},
+		{Type: gd.TypeObject, Name: name(0xB1), ClassName: name(0xB2), Hint: -1, HintString: pointers.Raw[gd.String]([1]gd.EnginePointer{0xB3}), Usage: 2},
+	}
+	const ptr = 0x1000
+	words := propertyWords(ptr, list)
+	slots := uint64(ptr + 8*propertyInfoWords*len(list))
+	expect := []uint64{
+		uint64(gd.TypeInt), slots, slots + 8, 1, slots + 16, 6,
+		uint64(gd.TypeObject), slots + 24, slots + 32, 0xFFFFFFFF, slots + 40, 2,
+		0xA1, 0xA2, 0xA3,
+		0xB1, 0xB2, 0xB3,
+	}
+	if !slices.Equal(words, expect) {
+		t.Fatalf("expected %#x, got %#x", expect, words)
+	}
+	for i := range list { // each pointer must address the value it refers to.
+		for field, want := range []uint64{uint64(0xA1 + 0x10*i), uint64(0xA2 + 0x10*i), uint64(0xA3 + 0x10*i)} {
+			addr := words[propertyInfoWords*i+[]int{1, 2, 4}[field]]
+			if got := words[(addr-ptr)/8]; got != want {
+				t.Fatalf("property %d field %d: expected %#x, got %#x", i, field, want, got)
+			}
+		}
+	}
+	if words := propertyWords(ptr, nil); len(words) != 0 {
+		t.Fatalf("expected no words for an empty list, got %v", words)
+	}
+}
+
+func TestSavedStateEncoding(t *testing.T) {
+	str := func(s string) any { return s }
+	num := func(n int64) any { return n }
+	state := savedState[any]{
+		registered: map[string]bool{"class Player": true, "method Player.Jump": true},
+		instances: []savedInstance[any]{
+			{ptr: 0x100, class: "Player", properties: []savedProperty[any]{{"health", 3.5}, {"name", "bob"}}},
+			{ptr: 0x200, class: "Empty"},
+		},
+	}
+	values := state.encode(str, num)
+	expect := []any{
+		"class Player\nmethod Player.Jump",
+		int64(0x100), "Player", int64(2), "health", 3.5, "name", "bob",
+		int64(0x200), "Empty", int64(0),
+	}
+	if !reflect.DeepEqual(values, expect) {
+		t.Fatalf("expected %v, got %v", expect, values)
+	}
+	decode := func(values []any) savedState[any] {
+		return decodeState(values, func(v any) string { return fmt.Sprint(v) }, func(v any) int64 { return v.(int64) })
+	}
+	if decoded := decode(values); !reflect.DeepEqual(decoded, state) {
+		t.Fatalf("expected %v, got %v", state, decoded)
+	}
+	truncated := decode(values[:len(values)-4])
+	if len(truncated.instances) != 0 || !truncated.registered["method Player.Jump"] {
+		t.Fatalf("expected the truncated instance to be dropped, got %v", truncated)
+	}
+	if empty := decode(nil); empty.registered == nil || len(empty.instances) != 0 {
+		t.Fatalf("expected an empty state, got %v", empty)
+	}
+}
+
+func TestUnbridgeable(t *testing.T) {
+	header, err := os.ReadFile("gdextension_interface.h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(header) == 0 || !strings.Contains(string(header), "@name mem_alloc") {
+		t.Fatal("expected the header to declare the interface functions")
+	}
+	if unsupported := unbridgeable(string(header)); len(unsupported) != 0 {
+		t.Fatalf("the reloads host cannot call these interface functions: %v", unsupported)
+	}
+	unsupported := unbridgeable(`
+/**
+ * @name scale
+ */
+typedef void (*GDExtensionInterfaceScale)(GDExtensionTypePtr p_self, double p_factor);
+/**
+ * @name scale_ptr
+ */
+typedef void (*GDExtensionInterfaceScalePtr)(GDExtensionTypePtr p_self, const double *p_factor);
+/**
+ * @name many
+ */
+typedef void (*GDExtensionInterfaceMany)(int a, int b, int c, int d, int e, int f, int g, int h, int i);
+/**
+ * @name none
+ */
+typedef void (*GDExtensionInterfaceNone)(void);
+`)
+	expect := map[string]string{"scale": "a floating point parameter", "many": "9 parameters"}
+	if !maps.Equal(unsupported, expect) {
+		t.Fatalf("expected %v, got %v", expect, unsupported)
+	}
+}
+
+func TestModuleLock(t *testing.T) {
+	var lock moduleLock
+	if depth := lock.enter(1); depth != 1 {
+		t.Fatalf("expected depth 1, got %d", depth)
+	}
+	if depth := lock.enter(1); depth != 2 {
+		t.Fatalf("expected the owner to re-enter at depth 2, got %d", depth)
+	}
+	entered := make(chan int)
+	go func() {
+		entered <- lock.enter(2)
+		lock.leave()
+	}()
+	lock.leave()
+	select {
+	case <-entered:
+		t.Fatal("expected another thread to wait whilst the owner is still inside")
+	case <-time.After(10 * time.Millisecond):
+	}
+	if depth := lock.enter(1); depth != 2 {
+		t.Fatalf("expected the owner to keep the lock, got depth %d", depth)
+	}
+	lock.leave()
+	lock.leave()
+	select {
+	case depth := <-entered:
+		if depth != 1 {
+			t.Fatalf("expected the waiting thread to enter at depth 1, got %d", depth)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the waiting thread to enter once the owner left")
+	}
+}
+
+func TestFrameStack(t *testing.T) {
+	stack := frameStack{base: 0x1000, size: 64}
+	outer := stack.used
+	if ptr, ok := stack.alloc(8); !ok || ptr != 0x1000 {
+		t.Fatalf("expected 0x1000, got %#x %v", ptr, ok)
+	}
+	inner := stack.used // the engine calls back in, whilst the outer frame is in use.
+	if ptr, ok := stack.alloc(20); !ok || ptr != 0x1010 {
+		t.Fatalf("expected 0x1010, got %#x %v", ptr, ok)
+	}
+	if ptr, ok := stack.alloc(32); ok {
+		t.Fatalf("expected the stack to be full, got %#x", ptr)
+	}
+	stack.used = inner
+	if ptr, ok := stack.alloc(48); !ok || ptr != 0x1010 {
+		t.Fatalf("expected the inner frame to be reused at 0x1010, got %#x %v", ptr, ok)
+	}
+	stack.used = outer
+	if stack.used != 0 {
+		t.Fatalf("expected an empty stack, got %d", stack.used)
+	}
+}
+
+func TestCallbackSlots(t *testing.T) {
+	for slot := range slotCallableCall + 1 {
+		checkCallbackSlot(slot)
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected a panic once the callback slots run out")
+			}
+		}()
+		checkCallbackSlot(callbackSlots)
+	}()
+	host, err := os.ReadFile("reloads.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(host), "SLOT(") - 1; n != callbackSlots {
+		t.Fatalf("expected the host to define %d slots, got %d", callbackSlots, n)
+	}
+	if !strings.Contains(string(host), fmt.Sprintf("slots[%d]", callbackSlots)) {
+		t.Fatalf("expected the host's table to have %d slots", callbackSlots)
+	}
+}
+
+func TestUnregistered(t *testing.T) {
+	previous := map[string]bool{"class Player": true, "method Player.Jump": true, "method Player.Run": true}
+	current := map[string]bool{"class Player": true, "method Player.Run": true, "method Player.Walk": true}
+	if stale := unregistered(previous, current); !slices.Equal(stale, []string{"method Player.Jump"}) {
+		t.Fatalf("expected the removed method, got %v", stale)
+	}
+	if stale := unregistered(current, current); len(stale) != 0 {
+		t.Fatalf("expected nothing to be stale, got %v", stale)
+	}
+}
@@ -0,0 +1,519 @@
+package startup
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"unsafe"
+
+	gd "graphics.gd/internal"
+	"graphics.gd/internal/pointers"
+)
+
+// The engine keeps any userdata and function pointers that were passed to it during registration,
+// so that these stay valid across reloads, classes and methods are identified by keys that
+// are allocated in engine memory (which outlives the module) and each callback is a trampoline
+// in the host (which dispatches to the current module), see [key] and [host_callback].
+
+type instance struct {
+	oi    gd.ObjectInterface
+	class string
+}
+
+var (
+	saved      uint64                  // engine Array from the previous generation, see [save].
+	registered map[string]bool         // with the engine, by any generation.
+	made       = make(map[string]bool) // by this generation.
+
+	keys  = make(map[string]uint64)
+	names = make(map[uint64]string)
+
+	classes   = make(map[string]gd.ClassInterface)
+	class4    = make(map[string]bool) // registered with classdb_register_extension_class4.
+	methods   = make(map[string]*gd.Method)
+	virtuals  = make(map[string]any)
+	instances = make(map[uint64]instance) // by engine pointer.
+)
+
+// register reports whether the given registration needs to be made with the engine, it will
+// have already been made if the module has been reloaded.
+func register(registration string) bool {
+	made[registration] = true
+	if registered[registration] {
+		return false
+	}
+	registered[registration] = true
+	return true
+}
+
+// key returns the engine memory that identifies name, as userdata for the engine.
+func key(name string) uint64 {
+	if ptr, ok := keys[name]; ok {
+		return ptr
+	}
+	ptr := mem_alloc.call(uint64(8 + len(name)))
+	store(ptr, uint64(len(name)))
+	if len(name) > 0 {
+		host_write(ptr+8, unsafe.Pointer(unsafe.StringData(name)), uint32(len(name)))
+	}
+	keys[name] = ptr
+	names[ptr] = name
+	return ptr
+}
+
+// nameOf returns the name identified by a [key], which may have been allocated by a previous
+// generation of the module.
+func nameOf(ptr uint64) string {
+	if name, ok := names[ptr]; ok {
+		return name
+	}
+	buf := make([]byte, load[uint64](ptr))
+	if len(buf) > 0 {
+		host_read(unsafe.Pointer(&buf[0]), ptr+8, uint32(len(buf)))
+	}
+	name := string(buf)
+	keys[name] = ptr
+	names[ptr] = name
+	return name
+}
+
+func virtualOf(name string) any {
+	if virtual, ok := virtuals[name]; ok {
+		return virtual
+	}
+	className, method, _ := strings.Cut(name, ".")
+	class, ok := classes[className]
+	if !ok {
+		return nil
+	}
+	virtual := class.GetVirtual(gd.NewStringName(method))
+	virtuals[name] = virtual
+	return virtual
+}
+
+// propertyList writes the list into memory from alloc, see [propertyWords].
+func propertyList(alloc func(uint64) uint64, list []gd.PropertyInfo) uint64 {
+	if len(list) == 0 {
+		return 0
+	}
+	ptr := alloc(uint64(8 * len(list) * (propertyInfoWords + 3)))
+	words := propertyWords(ptr, list)
+	host_write(ptr, unsafe.Pointer(&words[0]), uint32(8*len(words)))
+	return ptr
+}
+
+// metadata writes the argument metadata onto the frame.
+func (f *frame) metadata(list []gd.ClassMethodArgumentMetadata) uint64 {
+	if len(list) == 0 {
+		return 0
+	}
+	ptr := f.alloc(uint64(4 * len(list)))
+	host_write(ptr, unsafe.Pointer(&list[0]), uint32(4*len(list)))
+	return ptr
+}
+
+// linkClassDB implements the class registration functions of the GDExtension API via the host.
+func linkClassDB(API *gd.API) {
+	classdb_register_extension_class_signal := dlsym("classdb_register_extension_class_signal")
+	API.ClassDB.RegisterClassSignal = func(library gd.ExtensionToken, class, signal gd.StringName, args []gd.PropertyInfo) {
+		if !register("signal " + class.String() + "." + signal.String()) {
+			return
+		}
+		f := enter()
+		defer f.free()
+		classdb_register_extension_class_signal.call(uint64(library), arg(&f, pointers.Get(class)), arg(&f, pointers.Get(signal)),
+			propertyList(f.alloc, args), uint64(len(args)))
+	}
+	classdb_register_extension_class2 := dlsym("classdb_register_extension_class2")
+	classdb_register_extension_class4 := dlsym("classdb_register_extension_class4") // Godot 4.4+
+	API.ClassDB.RegisterClass = func(library gd.ExtensionToken, name, extends gd.StringName, info gd.ClassInterface) {
+		classes[name.String()] = info
+		class4[name.String()] = classdb_register_extension_class4 != 0 && useClass4(info)
+		if !register("class " + name.String()) {
+			return
+		}
+		f := enter()
+		defer f.free()
+		flags := b64(info.IsVirtual()) | b64(info.IsAbstract())<<8 | b64(info.IsExposed())<<16
+		if class4[name.String()] {
+			var p_info [20]uint64 // GDExtensionClassCreationInfo4
+			p_info[0] = flags | b64(info.IsRuntime())<<24
+			if icon := info.IconPath(); icon != "" {
+				p_info[1] = arg(&f, pointers.Get(gd.NewString(icon)))
+			}
+			for i, slot := range []uint32{
+				slotSet, slotGet, slotGetPropertyList, slotFreePropertyList, slotPropertyCanRevert,
+				slotPropertyGetRevert,
+			} {
+				p_info[2+i] = host_callback(slot)
+			}
+			for i, slot := range []uint32{
+				slotNotification, slotToString, slotReference, slotUnreference, slotCreateInstance,
+				slotFreeInstance,
+			} {
+				p_info[9+i] = host_callback(slot)
+			}
+			p_info[17] = host_callback(slotGetVirtualCallData)
+			p_info[18] = host_callback(slotCallVirtualWithData)
+			p_info[19] = key(name.String())
+			classdb_register_extension_class4.call(uint64(library), arg(&f, pointers.Get(name)), arg(&f, pointers.Get(extends)), arg(&f, p_info))
+			return
+		}
+		var p_info [20]uint64 // GDExtensionClassCreationInfo2
+		p_info[0] = flags
+		for i, slot := range []uint32{
+			slotSet, slotGet, slotGetPropertyList, slotFreePropertyList, slotPropertyCanRevert,
+			slotPropertyGetRevert,
+		} {
+			p_info[1+i] = host_callback(slot)
+		}
+		for i, slot := range []uint32{
+			slotNotification, slotToString, slotReference, slotUnreference, slotCreateInstance,
+			slotFreeInstance,
+		} {
+			p_info[8+i] = host_callback(slot)
+		}
+		p_info[16] = host_callback(slotGetVirtualCallData)
+		p_info[17] = host_callback(slotCallVirtualWithData)
+		p_info[18] = host_callback(slotGetRID)
+		p_info[19] = key(name.String())
+		classdb_register_extension_class2.call(uint64(library), arg(&f, pointers.Get(name)), arg(&f, pointers.Get(extends)), arg(&f, p_info))
+	}
+	classdb_register_extension_class_integer_constant := dlsym("classdb_register_extension_class_integer_constant")
+	API.ClassDB.RegisterClassIntegerConstant = func(library gd.ExtensionToken, class, enum, constant gd.StringName, value int64, bitfield bool) {
+		if !register("constant " + class.String() + "." + enum.String() + "." + constant.String()) {
+			return
+		}
+		f := enter()
+		defer f.free()
+		classdb_register_extension_class_integer_constant.call(uint64(library), arg(&f, pointers.Get(class)),
+			arg(&f, pointers.Get(enum)), arg(&f, pointers.Get(constant)), uint64(value), b64(bitfield))
+	}
+	classdb_register_extension_class_property := dlsym("classdb_register_extension_class_property")
+	API.ClassDB.RegisterClassProperty = func(library gd.ExtensionToken, class gd.StringName, info gd.PropertyInfo, getter, setter gd.StringName) {
+		if !register("property " + class.String() + "." + info.Name.String()) {
+			return
+		}
+		f := enter()
+		defer f.free()
+		classdb_register_extension_class_property.call(uint64(library), arg(&f, pointers.Get(class)),
+			propertyList(f.alloc, []gd.PropertyInfo{info}), arg(&f, pointers.Get(getter)), arg(&f, pointers.Get(setter)))
+	}
+	classdb_register_extension_class_property_indexed := dlsym("classdb_register_extension_class_property_indexed")
+	API.ClassDB.RegisterClassPropertyIndexed = func(library gd.ExtensionToken, class gd.StringName, info gd.PropertyInfo, getter, setter gd.StringName, index int64) {
+		if !register("indexed " + class.String() + "." + info.Name.String()) {
+			return
+		}
+		f := enter()
+		defer f.free()
+		classdb_register_extension_class_property_indexed.call(uint64(library), arg(&f, pointers.Get(class)),
+			propertyList(f.alloc, []gd.PropertyInfo{info}), arg(&f, pointers.Get(getter)), arg(&f, pointers.Get(setter)), uint64(index))
+	}
+	classdb_register_extension_class_property_group := dlsym("classdb_register_extension_class_property_group")
+	API.ClassDB.RegisterClassPropertyGroup = func(library gd.ExtensionToken, class gd.StringName, group, prefix gd.String) {
+		if !register("group " + class.String() + "." + group.String()) {
+			return
+		}
+		f := enter()
+		defer f.free()
+		classdb_register_extension_class_property_group.call(uint64(library), arg(&f, pointers.Get(class)),
+			arg(&f, pointers.Get(group)), arg(&f, pointers.Get(prefix)))
+	}
+	classdb_register_extension_class_property_subgroup := dlsym("classdb_register_extension_class_property_subgroup")
+	API.ClassDB.RegisterClassPropertySubGroup = func(library gd.ExtensionToken, class gd.StringName, subGroup, prefix gd.String) {
+		if !register("subgroup " + class.String() + "." + subGroup.String()) {
+			return
+		}
+		f := enter()
+		defer f.free()
+		classdb_register_extension_class_property_subgroup.call(uint64(library), arg(&f, pointers.Get(class)),
+			arg(&f, pointers.Get(subGroup)), arg(&f, pointers.Get(prefix)))
+	}
+	classdb_register_extension_class_method := dlsym("classdb_register_extension_class_method")
+	API.ClassDB.RegisterClassMethod = func(library gd.ExtensionToken, class gd.StringName, info gd.Method) {
+		name := class.String() + "." + info.Name.String()
+		methods[name] = &info
+		if !register("method " + name) {
+			return
+		}
+		f := enter()
+		defer f.free()
+		var p_info [11]uint64 // GDExtensionClassMethodInfo
+		p_info[0] = arg(&f, pointers.Get(info.Name))
+		p_info[1] = key(name)
+		p_info[2] = host_callback(slotMethodCall)
+		p_info[3] = host_callback(slotMethodPointerCall)
+		p_info[4] = uint64(uint32(info.MethodFlags))
+		if info.ReturnValueInfo != nil {
+			p_info[4] |= 1 << 32
+			p_info[5] = propertyList(f.alloc, []gd.PropertyInfo{*info.ReturnValueInfo})
+		}
+		p_info[6] = uint64(info.ReturnValueMetadata) | uint64(len(info.Arguments))<<32
+		p_info[7] = propertyList(f.alloc, info.Arguments)
+		p_info[8] = f.metadata(info.ArgumentsMetadata)
+		p_info[9] = uint64(len(info.DefaultArguments))
+		p_info[10] = f.variants(info.DefaultArguments)
+		classdb_register_extension_class_method.call(uint64(library), arg(&f, pointers.Get(class)), arg(&f, p_info))
+	}
+	classdb_register_extension_class_virtual_method := dlsym("classdb_register_extension_class_virtual_method")
+	API.ClassDB.RegisterClassVirtualMethod = func(library gd.ExtensionToken, class gd.StringName, info gd.Method) {
+		if !register("virtual " + class.String() + "." + info.Name.String()) {
+			return
+		}
+		f := enter()
+		defer f.free()
+		returns := gd.PropertyInfo{Name: gd.NewStringName(""), ClassName: gd.NewStringName(""), HintString: gd.NewString("")}
+		if info.ReturnValueInfo != nil {
+			returns = *info.ReturnValueInfo
+		}
+		var p_info [11]uint64 // GDExtensionClassVirtualMethodInfo
+		p_info[0] = arg(&f, pointers.Get(info.Name))
+		p_info[1] = uint64(uint32(info.MethodFlags))
+		host_read(unsafe.Pointer(&p_info[2]), propertyList(f.alloc, []gd.PropertyInfo{returns}), 48)
+		p_info[8] = uint64(info.ReturnValueMetadata) | uint64(len(info.Arguments))<<32
+		p_info[9] = propertyList(f.alloc, info.Arguments)
+		p_info[10] = f.metadata(info.ArgumentsMetadata)
+		classdb_register_extension_class_virtual_method.call(uint64(library), arg(&f, pointers.Get(class)), arg(&f, p_info))
+	}
+	classdb_unregister_extension_class := dlsym("classdb_unregister_extension_class")
+	API.ClassDB.UnregisterClass = func(library gd.ExtensionToken, name gd.StringName) {
+		f := enter()
+		defer f.free()
+		classdb_unregister_extension_class.call(uint64(library), arg(&f, pointers.Get(name)))
+	}
+}
+
+// callback is called by the host, for each call that the engine makes into the extension.
+//
+//go:wasmexport callback
+func callback(slot uint32, a0, a1, a2, a3, a4, a5 uint64) uint64 {
+	defer gd.Recover()
+	switch slot {
+	case slotCreateInstance:
+		class, ok := classes[nameOf(a0)]
+		if !ok {
+			return 0
+		}
+		notify := class4[nameOf(a0)] && uint8(a1) != 0 // p_notify_postinitialize
+		return uint64(pointers.Get(createInstance(class, notify)[0])[0])
+	case slotGetVirtualCallData:
+		name := nameOf(a0) + "." + pointers.Let[gd.StringName](load[[1]gd.EnginePointer](a1)).String()
+		if virtualOf(name) == nil {
+			return 0
+		}
+		return key(name)
+	case slotMethodCall:
+		return methodCall(a0, a1, a2, a3, a4, a5)
+	case slotMethodPointerCall:
+		method, ok := methods[nameOf(a0)]
+		if !ok {
+			return 0
+		}
+		var instance any
+		if a1 != 0 {
+			instance = instances[a1].oi
+		}
+		method.PointerCall(instance, gd.Address(a2), gd.Address(a3))
+		return 0
+	case slotCallableCall:
+		return callableCall(a0, a1, a2, a3, a4)
+	case slotFreeInstance:
+		if instance, ok := instances[a1]; ok {
+			instance.oi.Free()
+			delete(instances, a1)
+		}
+		return 0
+	}
+	instance, ok := instances[a0]
+	if !ok {
+		return 0
+	}
+	oi := instance.oi
+	switch slot {
+	case slotSet:
+		return b64(oi.Set(pointers.Let[gd.StringName](load[[1]gd.EnginePointer](a1)), pointers.Let[gd.Variant](load[[3]uint64](a2))))
+	case slotGet:
+		value, ok := oi.Get(pointers.Let[gd.StringName](load[[1]gd.EnginePointer](a1)))
+		if !ok {
+			return 0
+		}
+		raw, _ := pointers.End(value)
+		store(a2, raw)
+		return 1
+	case slotGetPropertyList:
+		list := oi.GetPropertyList()
+		store(a1, uint32(len(list)))
+		return propertyList(func(size uint64) uint64 { return mem_alloc.call(size) }, list)
+	case slotFreePropertyList:
+		if a1 != 0 {
+			mem_free.call(a1)
+		}
+	case slotPropertyCanRevert:
+		return b64(oi.PropertyCanRevert(pointers.Let[gd.StringName](load[[1]gd.EnginePointer](a1))))
+	case slotPropertyGetRevert:
+		value, ok := oi.PropertyGetRevert(pointers.Let[gd.StringName](load[[1]gd.EnginePointer](a1)))
+		if !ok {
+			return 0
+		}
+		raw, _ := pointers.End(value)
+		store(a2, raw)
+		return 1
+	case slotNotification:
+		oi.Notification(int32(a1), uint8(a2) != 0)
+	case slotToString:
+		s, ok := oi.ToString()
+		store(a1, ok)
+		if ok {
+			raw, _ := pointers.End(s)
+			store(a2, raw)
+		}
+	case slotReference:
+		oi.Reference()
+	case slotUnreference:
+		oi.Unreference()
+	case slotCallVirtualWithData:
+		name := pointers.Let[gd.StringName](load[[1]gd.EnginePointer](a1))
+		oi.CallVirtual(name, virtualOf(nameOf(a2)), gd.Address(a3), gd.Address(a4))
+	case slotGetRID:
+		return uint64(oi.GetRID())
+	}
+	return 0
+}
+
+func methodCall(p_method, p_instance, p_args, count, p_ret, p_error uint64) uint64 {
+	method, ok := methods[nameOf(p_method)]
+	if !ok {
+		store(p_error, [3]int32{1}) // GDEXTENSION_CALL_ERROR_INVALID_METHOD
+		return 0
+	}
+	store(p_error, [3]int32{callFailed}) // until the call returns, so that a panic is reported as a failed call.
+	var variants = make([]gd.Variant, 0, count)
+	for i := range count {
+		variants = append(variants, pointers.Let[gd.Variant](load[[3]uint64](load[uint64](p_args+8*i))))
+	}
+	var instance any
+	if p_instance != 0 {
+		instance = instances[p_instance].oi
+	}
+	result, err := method.Call(instance, variants...)
+	if err != nil {
+		store(p_error, callErrorOf(err))
+		return 0
+	}
+	if result != (gd.Variant{}) {
+		raw, _ := pointers.End(result)
+		store(p_ret, raw)
+	}
+	store(p_error, [3]int32{})
+	return 0
+}
+
+func callableCall(p_callable, p_args, count, p_ret, p_error uint64) uint64 {
+	value, ok := handles.Load(uintptr(p_callable))
+	if !ok || uint32(p_callable>>32) != generation {
+		store(p_error, [3]int32{1}) // the callable was created before the last reload.
+		return 0
+	}
+	fn := value.(func(...gd.Variant) (gd.Variant, error))
+	store(p_error, [3]int32{callFailed}) // until the call returns, so that a panic is reported as a failed call.
+	var args = make([]gd.Variant, 0, count)
+	for i := range count {
+		args = append(args, pointers.Let[gd.Variant](load[[3]uint64](load[uint64](p_args+8*i))))
+	}
+	ret, err := fn(args...)
+	if err != nil {
+		return 0
+	}
+	store(p_ret, pointers.Get(ret))
+	store(p_error, [3]int32{})
+	return 0
+}
+
+// loadState decodes the engine Array saved by the previous generation of the module, if any.
+func loadState() savedState[gd.Variant] {
+	var values []gd.Variant
+	if saved != 0 {
+		state := pointers.Let[gd.Array]([1]gd.EnginePointer{gd.EnginePointer(saved)})
+		values = make([]gd.Variant, state.Size())
+		for i := range values {
+			values[i] = state.Index(int64(i))
+		}
+		pointers.End(state)
+	}
+	return decodeState(values,
+		func(value gd.Variant) string { return gd.Global.Variants.Stringify(value).String() },
+		func(value gd.Variant) int64 { return int64(value.Interface().(gd.Int)) },
+	)
+}
+
+// loadRegistrations decodes the registrations made by previous generations of the module.
+func loadRegistrations() {
+	registered = loadState().registered
+}
+
+// save is called by the host before the module is replaced, it returns an engine Array with
+// the registrations that have been made, followed by each live instance of an extension class,
+// as its engine pointer, class name and the number of stored properties, followed by the name
+// and value of each such property.
+//
+//go:wasmexport save
+func save() uint64 {
+	state := savedState[gd.Variant]{registered: registered}
+	for ptr, instance := range instances {
+		obj := [1]gd.Object{pointers.Raw[gd.Object]([3]uint64{ptr})}
+		saving := savedInstance[gd.Variant]{ptr: ptr, class: instance.class}
+		for _, property := range obj[0].GetPropertyList().Iter() {
+			info := gd.LetVariantAsPointerType[gd.Dictionary](property, gd.TypeDictionary)
+			usage := int64(info.Index(gd.NewVariant("usage")).Interface().(gd.Int))
+			if usage&2 != 0 { // PROPERTY_USAGE_STORAGE
+				name := gd.Global.Variants.Stringify(info.Index(gd.NewVariant("name"))).String()
+				saving.properties = append(saving.properties, savedProperty[gd.Variant]{name, obj[0].Get(gd.NewStringName(name))})
+			}
+		}
+		state.instances = append(state.instances, saving)
+	}
+	array := gd.NewArray()
+	for _, value := range state.encode(
+		func(s string) gd.Variant { return gd.NewVariant(s) },
+		func(n int64) gd.Variant { return gd.NewVariant(n) },
+	) {
+		array.PushBack(value)
+	}
+	raw, _ := pointers.End(array)
+	return uint64(raw[0])
+}
+
+// unlink is called by the host once the next generation of the module has been linked,
+// just before this module is closed, to release the engine memory used for its stack.
+//
+//go:wasmexport unlink
+func unlink() {
+	mem_free.call(stack.base)
+}
+
+// restore is called by the host after the module has been initialized, to bind the
+// instances saved by the previous generation to the new implementation of their class.
+//
+//go:wasmexport restore
+func restore() {
+	if saved == 0 {
+		return
+	}
+	if stale := unregistered(registered, made); len(stale) > 0 {
+		fmt.Fprintln(os.Stderr, "graphics.gd: restart the editor to remove:", strings.Join(stale, ", "))
+	}
+	for _, saving := range loadState().instances {
+		class, ok := classes[saving.class].(interface {
+			RecreateInstance([1]gd.Object) gd.ObjectInterface
+		})
+		if !ok {
+			continue // the class has been removed.
+		}
+		super := [1]gd.Object{pointers.Pin(pointers.Lay(pointers.New[gd.Object]([3]uint64{saving.ptr})))}
+		instances[saving.ptr] = instance{class.RecreateInstance(super), saving.class}
+		for _, property := range saving.properties {
+			super[0].Set(gd.NewStringName(property.name), property.value)
+		}
+	}
+	saved = 0
+}
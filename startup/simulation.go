@@ -0,0 +1,139 @@
+package startup
+
+import (
+	"iter"
+
+	EngineClass "graphics.gd/classdb/Engine"
+	"graphics.gd/variant/Float"
+)
+
+// Simulation is a deterministic, fixed-timestep loop, see [FixedTimestep]. Each tick advances the
+// simulation by the same timestep, regardless of the frame rate, such that the simulation can be
+// kept in lockstep across peers, or replayed from a recording of its inputs.
+type Simulation struct {
+	state *simulation
+}
+
+type simulation struct {
+	frames iter.Seq[Float.X]
+	rate   int // ticks per second.
+	tick   func(uint64)
+	rewind []func(uint64)
+	ticks  uint64
+	paused bool
+	steps  int // remaining, whilst paused.
+}
+
+// simulating is stepped by goMainLoop.PhysicsProcess, see [FixedTimestep].
+var simulating *simulation
+
+// FixedTimestep waits for the engine to startup, like [Rendering], and returns a [Simulation]
+// that calls tick with the number of each tick, at the given rate of ticks per second. Ticks
+// are the engine's physics frames, such that they run on the main thread at a fixed rate, at
+// most rate/4 times per frame (the simulation slows down, rather than falling further behind,
+// when ticks take too long). [Simulation.Frames] must be ranged over to run the simulation.
+//
+//	func main() {
+//		sim := startup.FixedTimestep(60, func(tick uint64) {
+//			world.Step(1.0 / 60)
+//		})
+//		for alpha := range sim.Frames() {
+//			world.Draw(alpha)
+//		}
+//	}
+func FixedTimestep(rate int, tick func(tick uint64)) Simulation {
+	if rate <= 0 {
+		panic("startup.FixedTimestep: rate must be positive")
+	}
+	if simulating != nil {
+		panic("startup.FixedTimestep: the simulation is already running")
+	}
+	frames := Rendering()
+	EngineClass.SetPhysicsTicksPerSecond(rate)
+	EngineClass.SetMaxPhysicsStepsPerFrame(max(1, rate/4))
+	EngineClass.SetPhysicsJitterFix(0) // schedule ticks from the engine's clock alone, not the frame rate.
+	simulating = &simulation{
+		frames: frames,
+		rate:   rate,
+		tick:   tick,
+	}
+	return Simulation{simulating}
+}
+
+// Frames returns an iterator over each frame, after the ticks that are due have run, yielding
+// the interpolation alpha between the previous tick and the next one (in the range [0, 1)),
+// such that frames can be rendered smoothly at any frame rate. The iterator will block until
+// the engine shuts down.
+func (sim Simulation) Frames() iter.Seq[Float.X] {
+	return func(yield func(Float.X) bool) {
+		for range sim.state.frames {
+			var alpha Float.X
+			if !sim.state.paused {
+				alpha = EngineClass.GetPhysicsInterpolationFraction()
+			}
+			if !yield(alpha) {
+				return
+			}
+		}
+	}
+}
+
+// physics is called each physics frame of the engine, running the next tick, unless the
+// simulation is paused, in which case only the ticks requested by [Simulation.Step] are run.
+func (sim *simulation) physics() {
+	if sim.paused {
+		for ; sim.steps > 0; sim.steps-- {
+			sim.run()
+		}
+		return
+	}
+	sim.run()
+}
+
+func (sim *simulation) run() {
+	sim.ticks++
+	sim.tick(sim.ticks)
+}
+
+// Tick returns the number of ticks that have run.
+func (sim Simulation) Tick() uint64 { return sim.state.ticks }
+
+// Timestep returns the time that each tick advances the simulation by, in seconds.
+func (sim Simulation) Timestep() Float.X { return Float.X(1 / float64(sim.state.rate)) }
+
+// Pause stops ticks from running, frames continue to be yielded, with an alpha of zero.
+func (sim Simulation) Pause() { sim.state.paused = true }
+
+// Resume running ticks after [Simulation.Pause], any time spent paused is skipped.
+func (sim Simulation) Resume() {
+	sim.state.paused = false
+	sim.state.steps = 0
+}
+
+// Paused reports whether the simulation is paused.
+func (sim Simulation) Paused() bool { return sim.state.paused }
+
+// Step runs n ticks on the next physics frame, whilst the simulation is paused.
+func (sim Simulation) Step(n int) {
+	if sim.state.paused {
+		sim.state.steps += n
+	}
+}
+
+// OnRewind adds a hook that is called by [Simulation.Rewind], it must restore the state of the
+// simulation to the state that it was in after the given tick.
+func (sim Simulation) OnRewind(hook func(tick uint64)) {
+	sim.state.rewind = append(sim.state.rewind, hook)
+}
+
+// Rewind the simulation back to the given tick, such that the next tick to run is tick+1. The
+// hooks added with [Simulation.OnRewind] are called to restore the state of the simulation.
+func (sim Simulation) Rewind(tick uint64) {
+	if tick > sim.state.ticks {
+		panic("startup.Simulation.Rewind: cannot rewind to a future tick")
+	}
+	sim.state.ticks = tick
+	for _, hook := range sim.state.rewind {
+		hook(tick)
+	}
+}
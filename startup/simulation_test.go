@@ -0,0 +1,68 @@
+package startup
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSimulation(t *testing.T) {
+	var ticks []uint64
+	sim := Simulation{&simulation{rate: 60, tick: func(tick uint64) { ticks = append(ticks, tick) }}}
+	if sim.Timestep() != 1.0/60 {
+		t.Fatalf("unexpected timestep %v", sim.Timestep())
+	}
+	for range 3 {
+		sim.state.physics()
+	}
+	if !slices.Equal(ticks, []uint64{1, 2, 3}) || sim.Tick() != 3 {
+		t.Fatalf("unexpected ticks %v", ticks)
+	}
+	sim.Pause()
+	sim.state.physics()
+	if sim.Tick() != 3 || !sim.Paused() {
+		t.Fatal("expected no ticks whilst paused")
+	}
+	sim.Step(2)
+	sim.state.physics()
+	sim.state.physics()
+	if !slices.Equal(ticks, []uint64{1, 2, 3, 4, 5}) {
+		t.Fatalf("expected two stepped ticks, got %v", ticks)
+	}
+	sim.Step(1)
+	sim.Resume()
+	sim.state.physics()
+	if !slices.Equal(ticks, []uint64{1, 2, 3, 4, 5, 6}) {
+		t.Fatalf("expected pending steps to be dropped on resume, got %v", ticks)
+	}
+	sim.Resume()
+	sim.Step(1)
+	if sim.state.steps != 0 {
+		t.Fatal("expected Step to be ignored whilst running")
+	}
+}
+
+func TestSimulationRewind(t *testing.T) {
+	var (
+		ticks    []uint64
+		restored []uint64
+	)
+	sim := Simulation{&simulation{rate: 30, tick: func(tick uint64) { ticks = append(ticks, tick) }}}
+	sim.OnRewind(func(tick uint64) { restored = append(restored, tick) })
+	for range 5 {
+		sim.state.physics()
+	}
+	sim.Rewind(2)
+	if sim.Tick() != 2 || !slices.Equal(restored, []uint64{2}) {
+		t.Fatalf("unexpected rewind to %v, restored %v", sim.Tick(), restored)
+	}
+	sim.state.physics()
+	if ticks[len(ticks)-1] != 3 {
+		t.Fatalf("expected tick 3 to run after rewinding to 2, got %v", ticks)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic when rewinding to a future tick")
+		}
+	}()
+	sim.Rewind(10)
+}
@@ -2,6 +2,8 @@
 package startup
 
 import (
+	"context"
+	"errors"
 	"iter"
 
 	"graphics.gd/classdb"
@@ -41,6 +43,11 @@ func MainLoop(loop MainLoopClass.Interface) {
 
 var theMainFunctionIsWaitingForTheEngineToShutDown = false
 
+// Context returns a context that is cancelled when the engine shuts down, either when the
+// main loop is finalized or when the extension is deinitialized, such that background
+// goroutines can stop before the engine goes away.
+func Context() context.Context { return gd.EngineContext() }
+
 // Deprecated: Use [Scene] instead.
 func Engine() { Scene() }
 
@@ -121,6 +128,9 @@ func (loop goMainLoop) Initialize() {
 // Called each physics frame with the time since the last physics frame as argument ([param delta], in seconds). Equivalent to [method Node._physics_process].
 // If implemented, the method must return a boolean value. [code]true[/code] ends the main loop, while [code]false[/code] lets it proceed to the next frame.
 func (loop goMainLoop) PhysicsProcess(delta Float.X) bool {
+	if simulating != nil {
+		simulating.physics()
+	}
 	if mainloop != nil {
 		return mainloop.PhysicsProcess(delta)
 	}
@@ -136,6 +146,8 @@ var frame_ready = make(chan bool)
 func (loop goMainLoop) Process(delta Float.X) bool {
 	defer Callable.Cycle()
 	defer pointers.Cycle()
+	defer releaseSignalConnections()
+	runDispatched()
 	if mainloop != nil {
 		return mainloop.Process(delta)
 	}
@@ -152,6 +164,7 @@ var main_loop_shutdown = make(chan struct{})
 
 // Called before the program exits.
 func (loop goMainLoop) Finalize() {
+	gd.CancelEngineContext()
 	if mainloop != nil {
 		mainloop.Finalize()
 	} else if pause_main != nil {
@@ -211,3 +224,34 @@ var (
 	resume_main func() (bool, bool)
 	stop_main   func()
 )
+
+// useClass4 reports whether the class needs to be registered with classdb_register_extension_class4
+// (Godot 4.4+), which is only required for runtime classes and classes with an icon.
+func useClass4(info gd.ClassInterface) bool {
+	return info.IsRuntime() || info.IconPath() != ""
+}
+
+// createInstance creates a new instance of the class. Classes registered with
+// classdb_register_extension_class4 are responsible for sending NOTIFICATION_POSTINITIALIZE
+// themselves, when notify is true.
+func createInstance(info gd.ClassInterface, notify bool) [1]gd.Object {
+	obj := info.CreateInstance()
+	if notify {
+		obj[0].Notification(0, false) // NOTIFICATION_POSTINITIALIZE
+	}
+	return obj
+}
+
+// callFailed is the call error reported to the engine when a Go function returns an error or
+// panics, as there is no generic call error.
+const callFailed = 7
+
+// callErrorOf returns the GDExtensionCallError to report to the engine for an error returned
+// by a Go function, such that a [gd.CallError] (ie. too few arguments) is reported as is.
+func callErrorOf(err error) [3]int32 {
+	var callErr *gd.CallError
+	if errors.As(err, &callErr) {
+		return [3]int32{int32(callErr.ErrorType), callErr.Argument, callErr.Expected}
+	}
+	return [3]int32{callFailed}
+}
@@ -103,6 +103,7 @@ package startup
 #cgo noescape array_ref
 #cgo noescape array_set_typed
 #cgo noescape dictionary_operator_index
+#cgo noescape dictionary_set_typed
 #cgo noescape object_method_bind_call
 #cgo noescape object_method_bind_ptrcall
 #cgo noescape object_destroy
@@ -123,7 +124,9 @@ package startup
 #cgo noescape get_library_path
 #cgo noescape callable_custom_create
 #cgo noescape classdb_register_extension_class2
+#cgo noescape classdb_register_extension_class4
 #cgo noescape classdb_register_extension_class_method
+#cgo noescape classdb_register_extension_class_virtual_method
 #cgo noescape classdb_register_extension_class_integer_constant
 #cgo noescape classdb_register_extension_class_property
 #cgo noescape classdb_register_extension_class_property_indexed
@@ -134,9 +137,19 @@ package startup
 #cgo noescape editor_remove_plugin
 #cgo noescape classdb_unregister_extension_class
 #cgo noescape editor_help_load_xml_from_utf8_chars_and_len
+#cgo nocallback thread_id
+#cgo noescape thread_id
 #include <stdlib.h>
 #include "gdextension_interface.h"
 
+#ifdef _WIN32
+__declspec(dllimport) unsigned long __stdcall GetCurrentThreadId(void);
+static inline uintptr_t thread_id(void) { return (uintptr_t)GetCurrentThreadId(); }
+#else
+#include <pthread.h>
+static inline uintptr_t thread_id(void) { return (uintptr_t)pthread_self(); }
+#endif
+
 typedef uintptr_t pointer;
 typedef const char* string;
 typedef char32_t rune;
@@ -413,6 +426,9 @@ static inline void array_ref(pointer fn, pointer p_self, pointer p_from) {
 static inline void array_set_typed(pointer fn, pointer p_self, GDExtensionVariantType p_type, pointer p_class_name, pointer p_script) {
 	((GDExtensionInterfaceArraySetTyped)fn)((GDExtensionTypePtr)p_self, p_type, (GDExtensionConstStringNamePtr)p_class_name, (GDExtensionConstVariantPtr)p_script);
 }
+static inline void dictionary_set_typed(pointer fn, pointer p_self, GDExtensionVariantType p_key_type, pointer p_key_class_name, pointer p_key_script, GDExtensionVariantType p_value_type, pointer p_value_class_name, pointer p_value_script) {
+	((GDExtensionInterfaceDictionarySetTyped)fn)((GDExtensionTypePtr)p_self, p_key_type, (GDExtensionConstStringNamePtr)p_key_class_name, (GDExtensionConstVariantPtr)p_key_script, p_value_type, (GDExtensionConstStringNamePtr)p_value_class_name, (GDExtensionConstVariantPtr)p_value_script);
+}
 static inline void *dictionary_operator_index(pointer fn, pointer p_self, pointer p_key) {
 	return ((GDExtensionInterfaceDictionaryOperatorIndex)fn)((GDExtensionTypePtr)p_self, (GDExtensionConstVariantPtr)p_key);
 }
@@ -492,6 +508,7 @@ extern void to_string_func(pointer p_instance, void* valid, void* r_ret);
 extern void reference_func(pointer p_instance);
 extern void unreference_func(pointer p_instance);
 extern pointer create_instance_func(pointer p_class);
+extern pointer create_instance_func4(pointer p_class, GDExtensionBool p_notify_postinitialize);
 extern void free_instance_func(pointer p_class, pointer p_instance);
 extern pointer get_virtual_call_data_func(pointer p_class, void* name);
 extern void call_virtual_with_data_func(pointer p_instance, void* name, pointer userdata, void* args, void* ret);
@@ -518,6 +535,35 @@ static inline void classdb_register_extension_class2(pointer fn, pointer p_libra
 	((GDExtensionInterfaceClassdbRegisterExtensionClass2)fn)((GDExtensionClassLibraryPtr)p_library, (GDExtensionConstStringNamePtr)p_class_name, (GDExtensionConstStringNamePtr)p_parent_class_name, p_extension_funcs);
 }
 
+static GDExtensionObjectPtr create_instance_func2(void *p_class, GDExtensionBool p_notify_postinitialize) {
+	return (GDExtensionObjectPtr)create_instance_func4((pointer)p_class, p_notify_postinitialize);
+}
+static void *get_virtual_call_data_func2(void *p_class, GDExtensionConstStringNamePtr p_name, uint32_t p_hash) {
+	return (void*)get_virtual_call_data_func((pointer)p_class, (void*)p_name);
+}
+static void free_property_list_func2(GDExtensionClassInstancePtr p_instance, const GDExtensionPropertyInfo *p_list, uint32_t p_count) {
+	free_property_list_func((pointer)p_instance, (GDExtensionPropertyInfo*)p_list);
+}
+
+static inline void classdb_register_extension_class4(pointer fn, pointer p_library, pointer p_class_name, pointer p_parent_class_name, GDExtensionClassCreationInfo4 *p_extension_funcs) {
+	p_extension_funcs->set_func = (void*)set_func;
+	p_extension_funcs->get_func = (void*)get_func;
+	p_extension_funcs->get_property_list_func = (void*)get_property_list_func;
+	p_extension_funcs->free_property_list_func = free_property_list_func2;
+	p_extension_funcs->property_can_revert_func = (void*)property_can_revert_func;
+	p_extension_funcs->property_get_revert_func = (void*)property_get_revert_func;
+	p_extension_funcs->notification_func = (void*)notification_func;
+	p_extension_funcs->to_string_func = (void*)to_string_func;
+	p_extension_funcs->reference_func = (void*)reference_func;
+	p_extension_funcs->unreference_func = (void*)unreference_func;
+	p_extension_funcs->create_instance_func = create_instance_func2;
+	p_extension_funcs->free_instance_func = (void*)free_instance_func;
+	p_extension_funcs->recreate_instance_func = 0;
+	p_extension_funcs->get_virtual_call_data_func = get_virtual_call_data_func2;
+	p_extension_funcs->call_virtual_with_data_func = (void*)call_virtual_with_data_func;
+	((GDExtensionInterfaceClassdbRegisterExtensionClass4)fn)((GDExtensionClassLibraryPtr)p_library, (GDExtensionConstStringNamePtr)p_class_name, (GDExtensionConstStringNamePtr)p_parent_class_name, p_extension_funcs);
+}
+
 extern void method_call(pointer p_method, pointer p_userdata, void* args, GDExtensionInt count, void* r_ret, GDExtensionCallError *r_error);
 extern void method_ptrcall(pointer p_method, pointer p_userdata, void* args, void* r_ret);
 
@@ -526,6 +572,9 @@ static inline void classdb_register_extension_class_method(pointer fn, pointer p
 	p_method_bind_info->ptrcall_func = (void*)method_ptrcall;
 	((GDExtensionInterfaceClassdbRegisterExtensionClassMethod)fn)((GDExtensionClassLibraryPtr)p_library, (GDExtensionConstStringNamePtr)p_class_name, p_method_bind_info);
 }
+static inline void classdb_register_extension_class_virtual_method(pointer fn, pointer p_library, pointer p_class_name, GDExtensionClassVirtualMethodInfo *p_method_info) {
+	((GDExtensionInterfaceClassdbRegisterExtensionClassVirtualMethod)fn)((GDExtensionClassLibraryPtr)p_library, (GDExtensionConstStringNamePtr)p_class_name, p_method_info);
+}
 static inline void classdb_register_extension_class_integer_constant(pointer fn, pointer p_library, pointer p_class_name, pointer p_enum_name, pointer p_constant_name, int64_t p_constant_value, GDExtensionBool p_is_bitfield) {
 	((GDExtensionInterfaceClassdbRegisterExtensionClassIntegerConstant)fn)((GDExtensionClassLibraryPtr)p_library, (GDExtensionConstStringNamePtr)p_class_name, (GDExtensionConstStringNamePtr)p_enum_name, (GDExtensionConstStringNamePtr)p_constant_name, p_constant_value, p_is_bitfield);
 }
@@ -588,9 +637,31 @@ func doInitialization(init *initialization) {
 	C.initialization(init)
 }
 
+// mainThread is the thread that the engine initializes the extension on.
+var mainThread C.uintptr_t
+
+func init() {
+	onMainThread = func() bool { return mainThread != 0 && C.thread_id() == mainThread }
+}
+
+// currentThread returns the identifier of the calling thread.
+func currentThread() uintptr { return uintptr(C.thread_id()) }
+
+// checkThread panics when GDDEBUG=threads is set and the engine is called off the main thread.
+func checkThread() {
+	if debugThreads && C.thread_id() != mainThread {
+		panic(wrongThread)
+	}
+}
+
 //export initialize
 func initialize(_ unsafe.Pointer, level initializationLevel) {
+	mainThread = C.thread_id()
 	internal.Global.Init(gd.GDExtensionInitializationLevel(level))
+	if reloader != nil {
+		reloader.initialize(gd.GDExtensionInitializationLevel(level))
+		return
+	}
 	if level == 2 {
 		for _, fn := range internal.StartupFunctions {
 			fn()
@@ -606,7 +677,12 @@ func initialize(_ unsafe.Pointer, level initializationLevel) {
 
 //export deinitialize
 func deinitialize(_ unsafe.Pointer, level initializationLevel) {
+	if reloader != nil {
+		reloader.deinitialize(gd.GDExtensionInitializationLevel(level))
+		return
+	}
 	if level == 2 {
+		internal.CancelEngineContext()
 		for _, cleanup := range internal.Cleanups() {
 			cleanup()
 		}
@@ -812,6 +888,7 @@ func linkCGO(API *gd.API) {
 	}
 	variant_call := dlsymGD("variant_call")
 	API.Variants.Call = func(self gd.Variant, method gd.StringName, args ...gd.Variant) (gd.Variant, error) {
+		checkThread()
 		var frame = callframe.New()
 		var p_self = callframe.Arg(frame, pointers.Get(self))
 		var p_method = callframe.Arg(frame, pointers.Get(method))
@@ -843,6 +920,7 @@ func linkCGO(API *gd.API) {
 	}
 	variant_call_static := dlsymGD("variant_call_static")
 	API.Variants.CallStatic = func(vtype gd.VariantType, method gd.StringName, args ...gd.Variant) (gd.Variant, error) {
+		checkThread()
 		var frame = callframe.New()
 		var p_method = callframe.Arg(frame, pointers.Get(method))
 		for _, arg := range args {
@@ -1316,6 +1394,7 @@ func linkCGO(API *gd.API) {
 		)
 		frame.Free()
 		return func(base callframe.Addr, args callframe.Args, ret callframe.Addr, c int32) {
+			checkThread()
 			C.call_variant_ptr_builtin_method(
 				C.uintptr_t(uintptr(fn)),
 				C.uintptr_t(base.Uintptr()),
@@ -1517,6 +1596,7 @@ func linkCGO(API *gd.API) {
 		)
 		frame.Free()
 		return func(ret callframe.Addr, args callframe.Args, c int32) {
+			checkThread()
 			C.call_variant_ptr_utility_function(
 				C.uintptr_t(uintptr(fn)),
 				C.uintptr_t(ret.Uintptr()),
@@ -1777,6 +1857,28 @@ func linkCGO(API *gd.API) {
 		)
 		frame.Free()
 	}
+	dictionary_set_typed := dlsymGD("dictionary_set_typed") // Godot 4.4+
+	if dictionary_set_typed != nil {
+		API.Dictionary.SetTyped = func(self gd.Dictionary, key gd.VariantType, keyClassName gd.StringName, keyScript gd.Object, val gd.VariantType, valClassName gd.StringName, valScript gd.Object) {
+			var frame = callframe.New()
+			var p_self = callframe.Arg(frame, pointers.Get(self))
+			var p_keyClassName = callframe.Arg(frame, pointers.Get(keyClassName))
+			var p_keyScript = callframe.Arg(frame, pointers.Get(keyScript))
+			var p_valClassName = callframe.Arg(frame, pointers.Get(valClassName))
+			var p_valScript = callframe.Arg(frame, pointers.Get(valScript))
+			C.dictionary_set_typed(
+				C.uintptr_t(uintptr(dictionary_set_typed)),
+				C.uintptr_t(p_self.Uintptr()),
+				C.GDExtensionVariantType(key),
+				C.uintptr_t(p_keyClassName.Uintptr()),
+				C.uintptr_t(p_keyScript.Uintptr()),
+				C.GDExtensionVariantType(val),
+				C.uintptr_t(p_valClassName.Uintptr()),
+				C.uintptr_t(p_valScript.Uintptr()),
+			)
+			frame.Free()
+		}
+	}
 	dictionary_operator_index := dlsymGD("dictionary_operator_index")
 	API.Dictionary.Index = func(d gd.Dictionary, key gd.Variant) gd.Variant {
 		var frame = callframe.New()
@@ -1824,6 +1926,7 @@ func linkCGO(API *gd.API) {
 	}
 	object_method_bind_call := dlsymGD("object_method_bind_call")
 	API.Object.MethodBindCall = func(method gd.MethodBind, obj [1]gd.Object, arg ...gd.Variant) (gd.Variant, error) {
+		checkThread()
 		var self = pointers.Get(obj[0])
 		if self[0] == 0 {
 			return gd.Variant{}, errors.New("nil gd.Object dereference")
@@ -1867,6 +1970,7 @@ func linkCGO(API *gd.API) {
 	object_method_bind_ptrcall = dlsymGD("object_method_bind_ptrcall")
 	API.Object.MethodBindPointerCall = method_bind_ptrcall
 	API.Object.MethodBindPointerCallStatic = func(method gd.MethodBind, arg callframe.Args, ret callframe.Addr) {
+		checkThread()
 		C.object_method_bind_ptrcall(
 			C.uintptr_t(uintptr(object_method_bind_ptrcall)),
 			C.uintptr_t(method),
@@ -2188,6 +2292,7 @@ func linkCGO(API *gd.API) {
 	}
 
 	classdb_register_extension_class2 := dlsymGD("classdb_register_extension_class2")
+	classdb_register_extension_class4 := dlsymGD("classdb_register_extension_class4") // Godot 4.4+
 	API.ClassDB.RegisterClass = func(library gd.ExtensionToken, name, extends gd.StringName, info gd.ClassInterface) {
 		var frame = callframe.New()
 		var p_name = callframe.Arg(frame, pointers.Get(name))
@@ -2204,6 +2309,32 @@ func linkCGO(API *gd.API) {
 		if info.IsExposed() {
 			is_exposed = 1
 		}
+		if classdb_register_extension_class4 != nil && useClass4(info) {
+			var is_runtime C.GDExtensionBool
+			if info.IsRuntime() {
+				is_runtime = 1
+			}
+			var p_info = C.GDExtensionClassCreationInfo4{
+				is_virtual:  is_virtual,
+				is_abstract: is_abstract,
+				is_exposed:  is_exposed,
+				is_runtime:  is_runtime,
+			}
+			if icon := info.IconPath(); icon != "" {
+				var p_icon = callframe.Arg(frame, pointers.Get(gd.NewString(icon)))
+				*(*uintptr)(unsafe.Pointer(&p_info.icon_path)) = p_icon.Uintptr()
+			}
+			*(*uintptr)(unsafe.Pointer(&p_info.class_userdata)) = uintptr(cgo.NewHandle(info))
+			C.classdb_register_extension_class4(
+				C.uintptr_t(uintptr(classdb_register_extension_class4)),
+				C.uintptr_t(uintptr(library)),
+				C.uintptr_t(p_name.Uintptr()),
+				C.uintptr_t(p_extends.Uintptr()),
+				&p_info,
+			)
+			frame.Free()
+			return
+		}
 		var p_info = C.GDExtensionClassCreationInfo2{
 			is_virtual:  is_virtual,
 			is_abstract: is_abstract,
@@ -2422,6 +2553,78 @@ func linkCGO(API *gd.API) {
 		frame.Free()
 	}
 
+	classdb_register_extension_class_virtual_method := dlsymGD("classdb_register_extension_class_virtual_method")
+	API.ClassDB.RegisterClassVirtualMethod = func(library gd.ExtensionToken, class gd.StringName, info gd.Method) {
+		var pins runtime.Pinner
+		defer pins.Unpin()
+
+		var name = pointers.Get(info.Name)
+		pins.Pin(&name)
+
+		var returnInfo C.GDExtensionPropertyInfo
+		if info.ReturnValueInfo != nil {
+			var retName = pointers.Get(info.ReturnValueInfo.Name)
+			pins.Pin(&retName)
+
+			var className = pointers.Get(info.ReturnValueInfo.ClassName)
+			pins.Pin(&className)
+
+			var hintString = pointers.Get(info.ReturnValueInfo.HintString)
+			pins.Pin(&hintString)
+
+			returnInfo = C.GDExtensionPropertyInfo{
+				_type:       C.GDExtensionVariantType(info.ReturnValueInfo.Type),
+				name:        (C.GDExtensionStringNamePtr)(unsafe.Pointer(&retName)),
+				class_name:  (C.GDExtensionStringNamePtr)(unsafe.Pointer(&className)),
+				hint:        C.uint32_t(info.ReturnValueInfo.Hint),
+				hint_string: (C.GDExtensionStringPtr)(unsafe.Pointer(&hintString)),
+				usage:       C.uint32_t(info.ReturnValueInfo.Usage),
+			}
+		} else {
+			var empty = pointers.Get(gd.NewStringName(""))
+			pins.Pin(&empty)
+			var emptyString = pointers.Get(gd.NewString(""))
+			pins.Pin(&emptyString)
+			returnInfo = C.GDExtensionPropertyInfo{
+				name:        (C.GDExtensionStringNamePtr)(unsafe.Pointer(&empty)),
+				class_name:  (C.GDExtensionStringNamePtr)(unsafe.Pointer(&empty)),
+				hint_string: (C.GDExtensionStringPtr)(unsafe.Pointer(&emptyString)),
+			}
+		}
+
+		var list, free = cPropertyList(info.Arguments)
+		defer free()
+
+		var firstMetadata *C.GDExtensionClassMethodArgumentMetadata
+		var metadatas = make([]C.GDExtensionClassMethodArgumentMetadata, 0, len(info.ArgumentsMetadata))
+		for _, metadata := range info.ArgumentsMetadata {
+			metadatas = append(metadatas, C.GDExtensionClassMethodArgumentMetadata(metadata))
+		}
+		if len(metadatas) > 0 {
+			firstMetadata = &metadatas[0]
+			pins.Pin(&metadatas[0])
+		}
+
+		var frame = callframe.New()
+		var p_class = callframe.Arg(frame, pointers.Get(class))
+		var p_info = C.GDExtensionClassVirtualMethodInfo{
+			name:                  (C.GDExtensionStringNamePtr)(unsafe.Pointer(&name)),
+			method_flags:          C.uint32_t(info.MethodFlags),
+			return_value:          returnInfo,
+			return_value_metadata: C.GDExtensionClassMethodArgumentMetadata(info.ReturnValueMetadata),
+			argument_count:        C.uint32_t(len(info.Arguments)),
+			arguments:             list,
+			arguments_metadata:    firstMetadata,
+		}
+		C.classdb_register_extension_class_virtual_method(
+			C.uintptr_t(uintptr(classdb_register_extension_class_virtual_method)),
+			C.uintptr_t(uintptr(library)),
+			C.uintptr_t(p_class.Uintptr()),
+			&p_info,
+		)
+		frame.Free()
+	}
+
 	editor_add_plugin := dlsymGD("editor_add_plugin")
 	API.EditorPlugins.Add = func(plugin gd.StringName) {
 		var frame = callframe.New()
@@ -2522,6 +2725,7 @@ func makePackedFunctions[T gd.Packed[T, V], V Packed.Type](prefix string) gd.Pac
 
 //export set_func
 func set_func(p_instance uintptr, p_name, p_value unsafe.Pointer) bool {
+	defer gd.Recover()
 	name := pointers.Let[gd.StringName](*(*[1]gd.EnginePointer)(p_name))
 	value := pointers.Let[gd.Variant](*(*[3]uint64)(p_value))
 	return cgo.Handle(p_instance).Value().(gd.ObjectInterface).Set(name, value)
@@ -2529,6 +2733,7 @@ func set_func(p_instance uintptr, p_name, p_value unsafe.Pointer) bool {
 
 //export get_func
 func get_func(p_instance uintptr, p_name, p_value unsafe.Pointer) bool {
+	defer gd.Recover()
 	name := pointers.Let[gd.StringName](*(*[1]gd.EnginePointer)(p_name))
 	variant, ok := cgo.Handle(p_instance).Value().(gd.ObjectInterface).Get(name)
 	if !ok {
@@ -2573,6 +2778,7 @@ var propertyLists = make(map[uintptr]func())
 
 //export get_property_list_func
 func get_property_list_func(p_instance uintptr, p_length *uint32) *C.GDExtensionPropertyInfo {
+	defer gd.Recover()
 	list := cgo.Handle(p_instance).Value().(gd.ObjectInterface).GetPropertyList()
 	*p_length = uint32(len(list))
 	clist, free := cPropertyList(list)
@@ -2582,17 +2788,20 @@ func get_property_list_func(p_instance uintptr, p_length *uint32) *C.GDExtension
 
 //export free_property_list_func
 func free_property_list_func(p_instance uintptr, p_properties *C.GDExtensionPropertyInfo) {
+	defer gd.Recover()
 	propertyLists[p_instance]()
 }
 
 //export property_can_revert_func
 func property_can_revert_func(p_instance uintptr, p_name unsafe.Pointer) bool {
+	defer gd.Recover()
 	name := pointers.Let[gd.StringName](*(*[1]gd.EnginePointer)(p_name))
 	return cgo.Handle(p_instance).Value().(gd.ObjectInterface).PropertyCanRevert(name)
 }
 
 //export property_get_revert_func
 func property_get_revert_func(p_instance uintptr, p_name, p_value unsafe.Pointer) bool {
+	defer gd.Recover()
 	name := pointers.Let[gd.StringName](*(*[1]gd.EnginePointer)(p_name))
 	variant, ok := cgo.Handle(p_instance).Value().(gd.ObjectInterface).PropertyGetRevert(name)
 	if ok {
@@ -2603,11 +2812,13 @@ func property_get_revert_func(p_instance uintptr, p_name, p_value unsafe.Pointer
 
 //export notification_func
 func notification_func(p_instance uintptr, p_notification int32, p_reversed bool) {
+	defer gd.Recover()
 	cgo.Handle(p_instance).Value().(gd.ObjectInterface).Notification(p_notification, p_reversed)
 }
 
 //export to_string_func
 func to_string_func(p_instance uintptr, valid, out unsafe.Pointer) {
+	defer gd.Recover()
 	s, ok := cgo.Handle(p_instance).Value().(gd.ObjectInterface).ToString()
 	if !ok {
 		*(*bool)(valid) = false
@@ -2619,26 +2830,37 @@ func to_string_func(p_instance uintptr, valid, out unsafe.Pointer) {
 
 //export reference_func
 func reference_func(p_instance uintptr) {
+	defer gd.Recover()
 	cgo.Handle(p_instance).Value().(gd.ObjectInterface).Reference()
 }
 
 //export unreference_func
 func unreference_func(p_instance uintptr) {
+	defer gd.Recover()
 	cgo.Handle(p_instance).Value().(gd.ObjectInterface).Unreference()
 }
 
 //export create_instance_func
 func create_instance_func(p_class uintptr) uintptr {
+	defer gd.Recover()
 	return uintptr(pointers.Get(cgo.Handle(p_class).Value().(gd.ClassInterface).CreateInstance()[0])[0])
 }
 
+//export create_instance_func4
+func create_instance_func4(p_class uintptr, p_notify_postinitialize C.GDExtensionBool) uintptr {
+	defer gd.Recover()
+	return uintptr(pointers.Get(createInstance(cgo.Handle(p_class).Value().(gd.ClassInterface), p_notify_postinitialize != 0)[0])[0])
+}
+
 //export free_instance_func
 func free_instance_func(_, p_instance uintptr) {
+	defer gd.Recover()
 	cgo.Handle(p_instance).Value().(gd.ObjectInterface).Free()
 }
 
 //export get_virtual_call_data_func
 func get_virtual_call_data_func(p_class uintptr, p_name unsafe.Pointer) uintptr {
+	defer gd.Recover()
 	var name = pointers.Let[gd.StringName](*(*[1]gd.EnginePointer)(p_name))
 	virtual := cgo.Handle(p_class).Value().(gd.ClassInterface).GetVirtual(name)
 	if virtual == nil {
@@ -2649,17 +2871,21 @@ func get_virtual_call_data_func(p_class uintptr, p_name unsafe.Pointer) uintptr
 
 //export call_virtual_with_data_func
 func call_virtual_with_data_func(p_instance uintptr, p_name unsafe.Pointer, p_data uintptr, p_args, p_ret unsafe.Pointer) {
+	defer gd.Recover()
 	var name = pointers.Let[gd.StringName](*(*[1]gd.EnginePointer)(p_name))
 	cgo.Handle(p_instance).Value().(gd.ObjectInterface).CallVirtual(name, cgo.Handle(p_data).Value(), gd.Address(p_args), gd.Address(p_ret))
 }
 
 //export get_rid_func
 func get_rid_func(p_instance uintptr) C.uint64_t {
+	defer gd.Recover()
 	return C.uint64_t(cgo.Handle(p_instance).Value().(gd.ObjectInterface).GetRID())
 }
 
 //export callable_call
 func callable_call(p_callable uintptr, p_args unsafe.Pointer, count C.GDExtensionInt, p_ret unsafe.Pointer, issue *C.GDExtensionCallError) {
+	defer gd.Recover()
+	issue.error = callFailed // until the call returns, so that a panic is reported as a failed call.
 	fn := cgo.Handle(p_callable).Value().(func(...gd.Variant) (gd.Variant, error))
 
 	var slice = unsafe.Slice((**[3]uint64)(p_args), int(count))
@@ -2670,7 +2896,6 @@ func callable_call(p_callable uintptr, p_args unsafe.Pointer, count C.GDExtensio
 	}
 	ret, err := fn(args...)
 	if err != nil {
-		issue.error = 7 // TODO no generic error>
 		return
 	}
 	*(*[3]uint64)(p_ret) = pointers.Get(ret)
@@ -2679,6 +2904,8 @@ func callable_call(p_callable uintptr, p_args unsafe.Pointer, count C.GDExtensio
 
 //export method_call
 func method_call(p_method uintptr, p_instance uintptr, p_args unsafe.Pointer, count C.GDExtensionInt, p_ret unsafe.Pointer, issue *C.GDExtensionCallError) {
+	defer gd.Recover()
+	issue.error = callFailed // until the call returns, so that a panic is reported as a failed call.
 	method := cgo.Handle(p_method).Value().(*gd.Method)
 	var variants = make([]gd.Variant, 0, int(count))
 	for _, elem := range unsafe.Slice((**[3]uint64)(p_args), int(count)) {
@@ -2686,16 +2913,21 @@ func method_call(p_method uintptr, p_instance uintptr, p_args unsafe.Pointer, co
 	}
 	result, err := method.Call(cgo.Handle(p_instance).Value(), variants...)
 	if err != nil {
-		issue.error = 7 // TODO no generic error>
+		callErr := callErrorOf(err)
+		issue.error = C.GDExtensionCallErrorType(callErr[0])
+		issue.argument = C.int32_t(callErr[1])
+		issue.expected = C.int32_t(callErr[2])
 		return
 	}
 	if result != (gd.Variant{}) {
 		*(*[3]uint64)(p_ret), _ = pointers.End(result)
 	}
+	*issue = C.GDExtensionCallError{}
 }
 
 //export method_ptrcall
 func method_ptrcall(p_method uintptr, p_instance uintptr, p_args unsafe.Pointer, p_ret unsafe.Pointer) {
+	defer gd.Recover()
 	method := cgo.Handle(p_method).Value().(*gd.Method)
 	var instance any
 	if p_instance != 0 {
@@ -2712,6 +2944,7 @@ func method_bind_ptrcall(method gd.MethodBind, obj [1]gd.Object, arg callframe.A
 	if obj == ([1]gd.Object{}) {
 		panic("nil gd.Object dereference")
 	}
+	checkThread()
 	var self = pointers.Get(obj[0])
 	if self[0] == 0 {
 		panic("nil gd.Object dereference")
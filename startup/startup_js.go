@@ -40,6 +40,7 @@ func init() {
 			return nil
 		}))
 		initialization.Set("deinitialize", js.FuncOf(func(_ js.Value, args []js.Value) any {
+			gd.CancelEngineContext()
 			for _, cleanup := range gd.Cleanups() {
 				cleanup()
 			}
@@ -133,6 +134,7 @@ func linkJS(API *gd.API) {
 	API.Callables.Create = func(fn func(...gd.Variant) (gd.Variant, error)) gd.Callable {
 		var info = js.Global().Get("Object").New()
 		info.Set("call_func", js.FuncOf(func(_ js.Value, js_args []js.Value) any {
+			defer gd.Recover()
 			var argc = js_args[0].Int()
 			var args = make([]gd.Variant, argc)
 			for i := 0; i < argc; i++ {
@@ -263,11 +265,14 @@ func linkJS(API *gd.API) {
 		info.Set("is_virtual", info_go.IsVirtual())
 		info.Set("is_abstract", info_go.IsAbstract())
 		info.Set("is_exposed", info_go.IsExposed())
-		info.Set("is_runtime", false)
+		info.Set("is_runtime", info_go.IsRuntime())
+		info.Set("icon_path", info_go.IconPath())
 		info.Set("create_instance", js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			return pointers.Get(info_go.CreateInstance()[0])[0]
 		}))
 		info.Set("get_virtual_call_data", js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			p_name := args[0].Int()
 			var name = pointers.Let[gd.StringName]([1]gd.EnginePointer{gd.EnginePointer(p_name)})
 			virtual := info_go.GetVirtual(name)
@@ -343,6 +348,7 @@ func linkJS(API *gd.API) {
 		handle := cgoNewHandle(oi)
 		wrapper.Set("ref", uint32(handle))
 		wrapper.Set("set", cleanup(js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			var field = pointers.Let[gd.StringName]([1]gd.EnginePointer{gd.EnginePointer(args[0].Int())})
 			var variant [6]uint32
 			for i := 0; i < len(variant); i++ {
@@ -351,6 +357,7 @@ func linkJS(API *gd.API) {
 			return oi.Set(field, pointers.Let[gd.Variant](*(*[3]uint64)(unsafe.Pointer(&variant))))
 		})))
 		wrapper.Set("get", cleanup(js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			var field = pointers.Let[gd.StringName]([1]gd.EnginePointer{gd.EnginePointer(args[0].Int())})
 			variant, ok := oi.Get(field)
 			if !ok {
@@ -364,6 +371,7 @@ func linkJS(API *gd.API) {
 			return true
 		})))
 		wrapper.Set("get_property_list", cleanup(js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			var list = oi.GetPropertyList()
 			var arr = js.Global().Get("Array").New(len(list))
 			for i, item_go := range list {
@@ -379,10 +387,12 @@ func linkJS(API *gd.API) {
 			return len(list)
 		})))
 		wrapper.Set("property_can_revert", cleanup(js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			var field = pointers.Let[gd.StringName]([1]gd.EnginePointer{gd.EnginePointer(args[0].Int())})
 			return oi.PropertyCanRevert(field)
 		})))
 		wrapper.Set("property_get_revert", cleanup(js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			var field = pointers.Let[gd.StringName]([1]gd.EnginePointer{gd.EnginePointer(args[0].Int())})
 			variant, ok := oi.PropertyGetRevert(field)
 			if !ok {
@@ -407,12 +417,14 @@ func linkJS(API *gd.API) {
 		return oi.ValidateProperty(property)
 		})))*/
 		wrapper.Set("notification", cleanup(js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			var what = int32(args[0].Int())
 			var reversed = args[1].Bool()
 			oi.Notification(what, reversed)
 			return nil
 		})))
 		wrapper.Set("to_string", cleanup(js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			str, ok := oi.ToString()
 			if !ok {
 				return false
@@ -421,14 +433,17 @@ func linkJS(API *gd.API) {
 			return true
 		})))
 		wrapper.Set("reference", cleanup(js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			oi.Reference()
 			return nil
 		})))
 		wrapper.Set("unreference", cleanup(js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			oi.Unreference()
 			return nil
 		})))
 		wrapper.Set("free", cleanup(js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			oi.Free()
 			for _, fn := range methods {
 				fn.Release()
@@ -437,6 +452,7 @@ func linkJS(API *gd.API) {
 			return nil
 		})))
 		wrapper.Set("call_virtual", cleanup(js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			name := pointers.Let[gd.StringName]([1]gd.EnginePointer{gd.EnginePointer(args[0].Int())})
 			wrap := cgoHandle(args[1].Int()).Value()
 			oi.CallVirtual(name, wrap, gd.Address(args[2].Int()), gd.Address(args[3].Int()))
@@ -535,12 +551,46 @@ func linkJS(API *gd.API) {
 		}
 		classdb_register_extension_class_signal.Invoke(uint32(library), pointers.Get(class)[0], pointers.Get(signal)[0], converted)
 	}
+	classdb_register_extension_class_virtual_method := dlsym("classdb_register_extension_class_virtual_method")
+	API.ClassDB.RegisterClassVirtualMethod = func(library gd.ExtensionToken, class gd.StringName, info gd.Method) {
+		converted := js.Global().Get("Object").New()
+		converted.Set("name", pointers.Get(info.Name)[0])
+		converted.Set("method_flags", uint32(info.MethodFlags))
+		if info.ReturnValueInfo != nil {
+			returnValueInfo := js.Global().Get("Object").New()
+			returnValueInfo.Set("name", pointers.Get(info.ReturnValueInfo.Name)[0])
+			returnValueInfo.Set("type", uint32(info.ReturnValueInfo.Type))
+			returnValueInfo.Set("hint", uint32(info.ReturnValueInfo.Hint))
+			returnValueInfo.Set("hint_string", pointers.Get(info.ReturnValueInfo.HintString)[0])
+			returnValueInfo.Set("usage", uint32(info.ReturnValueInfo.Usage))
+			returnValueInfo.Set("class_name", pointers.Get(info.ReturnValueInfo.ClassName)[0])
+			converted.Set("return_value", returnValueInfo)
+			converted.Set("return_value_metadata", uint32(info.ReturnValueMetadata))
+		}
+		var arguments = js.Global().Get("Array").New()
+		var argument_metadatas = js.Global().Get("Array").New()
+		for i, arg := range info.Arguments {
+			argument := js.Global().Get("Object").New()
+			argument.Set("name", pointers.Get(arg.Name)[0])
+			argument.Set("type", uint32(arg.Type))
+			argument.Set("hint", uint32(arg.Hint))
+			argument.Set("hint_string", pointers.Get(arg.HintString)[0])
+			argument.Set("usage", uint32(arg.Usage))
+			argument.Set("class_name", pointers.Get(arg.ClassName)[0])
+			arguments.Call("push", argument)
+			argument_metadatas.Call("push", uint32(info.ArgumentsMetadata[i]))
+		}
+		converted.Set("arguments", arguments)
+		converted.Set("arguments_metadata", argument_metadatas)
+		classdb_register_extension_class_virtual_method.Invoke(uint32(library), pointers.Get(class)[0], converted)
+	}
 	classdb_register_extension_class_method := dlsym("classdb_register_extension_class_method")
 	API.ClassDB.RegisterClassMethod = func(library gd.ExtensionToken, class gd.StringName, info gd.Method) {
 		converted := js.Global().Get("Object").New()
 		converted.Set("name", pointers.Get(info.Name)[0])
 		converted.Set("method_flags", uint32(info.MethodFlags))
 		converted.Set("call", js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			instance := cgoHandle(args[0].Int()).Value()
 			arg_count := args[1].Int()
 			var arguments = make([]gd.Variant, arg_count)
@@ -563,6 +613,7 @@ func linkJS(API *gd.API) {
 			return 0
 		}))
 		converted.Set("ptrcall", js.FuncOf(func(_ js.Value, args []js.Value) any {
+			defer gd.Recover()
 			info.PointerCall(cgoHandle(args[0].Int()).Value(), gd.Address(args[1].Int()), gd.Address(args[2].Int()))
 			return nil
 		}))
@@ -641,6 +692,10 @@ func linkJS(API *gd.API) {
 		}
 		return pointers.Let[gd.Variant](*(*[3]uint64)(unsafe.Pointer(&buf)))
 	}
+	dictionary_set_typed := dlsym("dictionary_set_typed")
+	API.Dictionary.SetTyped = func(self gd.Dictionary, key gd.VariantType, keyClassName gd.StringName, keyScript gd.Object, val gd.VariantType, valClassName gd.StringName, valScript gd.Object) {
+		dictionary_set_typed.Invoke(pointers.Get(self)[0], uint32(key), pointers.Get(keyClassName)[0], pointers.Get(keyScript)[0], uint32(val), pointers.Get(valClassName)[0], pointers.Get(valScript)[0])
+	}
 	array_set_typed := dlsym("array_set_typed")
 	API.Array.SetTyped = func(self gd.Array, t gd.VariantType, className gd.StringName, script gd.Object) {
 		array_set_typed.Invoke(pointers.Get(self)[0], uint32(t), pointers.Get(className)[0], pointers.Get(script)[0])
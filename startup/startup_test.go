@@ -0,0 +1,30 @@
+package startup
+
+import (
+	"testing"
+
+	"graphics.gd/variant/Float"
+)
+
+type countingMainLoop struct{ frames int }
+
+func (loop *countingMainLoop) Initialize()                 {}
+func (loop *countingMainLoop) PhysicsProcess(Float.X) bool { return false }
+func (loop *countingMainLoop) Process(delta Float.X) bool  { loop.frames++; return false }
+func (loop *countingMainLoop) Finalize()                   {}
+
+func TestMainLoopReleasesSignalConnections(t *testing.T) {
+	restore := releaseSignalConnections
+	defer func() { releaseSignalConnections = restore }()
+	var released int
+	releaseSignalConnections = func() { released++ }
+	loop := new(countingMainLoop)
+	mainloop = loop
+	defer func() { mainloop = nil }()
+	for range 3 {
+		goMainLoop{}.Process(0)
+	}
+	if loop.frames != 3 || released != 3 {
+		t.Fatalf("expected subscriptions to freed objects to be released each main loop frame, released %d times in %d frames", released, loop.frames)
+	}
+}
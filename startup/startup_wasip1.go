@@ -1,34 +1,1115 @@
 package startup
 
 import (
-	"fmt"
+	"errors"
+	"iter"
 	"unsafe"
 
 	gd "graphics.gd/internal"
+	"graphics.gd/internal/callframe"
+	"graphics.gd/internal/pointers"
+	"graphics.gd/variant/Packed"
+
+	"runtime.link/api"
+	"runtime.link/api/stub"
 )
 
-//go:wasmimport gdextension get_godot_version_major
-func get_godot_version_major() uint32
+// When built for wasip1, the extension is a module that is hosted by an extension built with
+// the 'reloads' tag (see reloads.go), such that it can be rebuilt and swapped while the engine
+// keeps running. The host bridges each GDExtension interface function into the module, as the
+// module cannot address engine memory, any values passed to the engine by pointer are copied
+// onto a stack that is allocated in engine memory.
+
+//go:wasmimport gdextension get_proc_address
+func get_proc_address(name unsafe.Pointer, length uint32) uint64
 
-//go:wasmimport gdextension get_godot_version_minor
-func get_godot_version_minor() uint32
+//go:wasmimport gdextension call
+func host_call(fn uint64, args unsafe.Pointer, argc uint32) uint64
 
-//go:wasmimport gdextension get_godot_version_patch
-func get_godot_version_patch() uint32
+//go:wasmimport gdextension read
+func host_read(dst unsafe.Pointer, src uint64, size uint32)
 
-//go:wasmimport gdextension string_name_new_with_utf8_chars_and_len
-func string_name_new_with_utf8_chars_and_len(chars unsafe.Pointer, len uint32) uint64
+//go:wasmimport gdextension write
+func host_write(dst uint64, src unsafe.Pointer, size uint32)
+
+//go:wasmimport gdextension callback
+func host_callback(slot uint32) uint64
 
-// If we are starting up under wasmip1 then that means we are peforming a hot-reload.
-// we need to link up with our host and initialise the Global state, we will then
-// receive all of the active extension classes so that they can be re-created.
 func init() {
-	gd.Global.GetGodotVersion = func() gd.Version {
+	gd.Global = api.Import[gd.API](stub.API, "", errors.New("gdextension not linked"))
+	singleThreaded = true
+}
+
+// proc is the address of a GDExtension interface function.
+type proc uint64
+
+func dlsym(name string) proc {
+	return proc(get_proc_address(unsafe.Pointer(unsafe.StringData(name)), uint32(len(name))))
+}
+
+// call the interface function, each argument is passed as a 64-bit integer, so any results
+// narrower than that must be truncated by the caller.
+func (fn proc) call(args ...uint64) uint64 {
+	if len(args) == 0 {
+		return host_call(uint64(fn), nil, 0)
+	}
+	return host_call(uint64(fn), unsafe.Pointer(&args[0]), uint32(len(args)))
+}
+
+var (
+	mem_alloc proc
+	mem_free  proc
+)
+
+// stack of engine memory, for passing values to the engine by pointer.
+var stack = frameStack{size: 1 << 20}
+
+// frame of the stack, the values copied onto it are released when the frame is freed.
+type frame struct {
+	mark uint64
+	back []copyback
+	heap []uint64 // allocations that did not fit onto the stack.
+}
+
+// copyback is a call frame value that is copied back from the stack when the frame is freed.
+type copyback struct {
+	ptr  uint64
+	addr *[16]uint32
+}
+
+func enter() frame { return frame{mark: stack.used} }
+
+func (f *frame) free() {
+	for _, back := range f.back {
+		host_read(unsafe.Pointer(back.addr), back.ptr, 64)
+	}
+	for _, ptr := range f.heap {
+		mem_free.call(ptr)
+	}
+	stack.used = f.mark
+}
+
+func (f *frame) alloc(size uint64) uint64 {
+	if ptr, ok := stack.alloc(size); ok {
+		return ptr
+	}
+	ptr := mem_alloc.call(size)
+	f.heap = append(f.heap, ptr)
+	return ptr
+}
+
+// arg copies the value onto the frame and returns its address in engine memory.
+func arg[T any](f *frame, value T) uint64 {
+	ptr := f.alloc(uint64(unsafe.Sizeof(value)))
+	host_write(ptr, unsafe.Pointer(&value), uint32(unsafe.Sizeof(value)))
+	return ptr
+}
+
+// ret reserves a zero T on the frame, for the engine to write a result into.
+func ret[T any](f *frame) uint64 {
+	var zero T
+	return arg(f, zero)
+}
+
+func load[T any](ptr uint64) (value T) {
+	host_read(unsafe.Pointer(&value), ptr, uint32(unsafe.Sizeof(value)))
+	return
+}
+
+func store[T any](ptr uint64, value T) {
+	host_write(ptr, unsafe.Pointer(&value), uint32(unsafe.Sizeof(value)))
+}
+
+// bytes copies b onto the frame, followed by a zero byte.
+func (f *frame) bytes(b []byte) uint64 {
+	ptr := f.alloc(uint64(len(b)) + 1)
+	if len(b) > 0 {
+		host_write(ptr, unsafe.Pointer(&b[0]), uint32(len(b)))
+	}
+	store(ptr+uint64(len(b)), byte(0))
+	return ptr
+}
+
+func (f *frame) cstring(s string) uint64 {
+	return f.bytes(unsafe.Slice(unsafe.StringData(s), len(s)))
+}
+
+// addr copies the call frame value onto the frame, it is copied back once the frame is freed,
+// so that any result written by the engine is visible to the caller.
+func (f *frame) addr(addr callframe.Addr) uint64 {
+	if addr.Uintptr() == 0 {
+		return 0
+	}
+	ptr := f.alloc(64)
+	host_write(ptr, addr.UnsafePointer(), 64)
+	f.back = append(f.back, copyback{ptr, addr.Pointer()})
+	return ptr
+}
+
+// args copies the call frame values onto the frame and returns an array of pointers to them.
+func (f *frame) args(args callframe.Args) uint64 {
+	if args.Len() == 0 {
+		return 0
+	}
+	list := make([]uint64, args.Len())
+	for i := range list {
+		list[i] = f.addr(args.Index(i))
+	}
+	return f.pointers(list)
+}
+
+// variants copies the variants onto the frame and returns an array of pointers to them.
+func (f *frame) variants(args []gd.Variant) uint64 {
+	if len(args) == 0 {
+		return 0
+	}
+	list := make([]uint64, len(args))
+	for i, variant := range args {
+		list[i] = arg(f, pointers.Get(variant))
+	}
+	return f.pointers(list)
+}
+
+func (f *frame) pointers(list []uint64) uint64 {
+	ptr := f.alloc(uint64(8 * len(list)))
+	host_write(ptr, unsafe.Pointer(&list[0]), uint32(8*len(list)))
+	return ptr
+}
+
+// cstring reads a zero-terminated string from engine memory.
+func cstring(ptr uint64) string {
+	var buf []byte
+	for ; ptr != 0; ptr++ {
+		c := load[byte](ptr)
+		if c == 0 {
+			break
+		}
+		buf = append(buf, c)
+	}
+	return string(buf)
+}
+
+// callError returns the GDExtensionCallError at ptr as an error, if it reports one.
+func callError(ptr uint64) error {
+	issue := load[[3]int32](ptr)
+	if issue[0] == 0 {
+		return nil
+	}
+	return &gd.CallError{
+		ErrorType: gd.CallErrorType(issue[0]),
+		Argument:  issue[1],
+		Expected:  issue[2],
+	}
+}
+
+func b64(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+var (
+	library    gd.ExtensionToken
+	generation uint32 // number of times the module has been reloaded.
+)
+
+// link is called by the host, once the module has been instantiated, state is the engine
+// Array returned by [save] from the previous generation of the module, if any.
+//
+//go:wasmexport link
+func link(token uint64, gen uint32, state uint64) {
+	library = gd.ExtensionToken(token)
+	generation = gen
+	handleIdx.Store(uintptr(gen) << 32)
+	saved = state
+	linkWASIP1(&gd.Global)
+	gd.Global.ExtensionToken = library
+	gd.Linked = true
+}
+
+//go:wasmexport initialize
+func initialize(level uint32) {
+	gd.Global.Init(gd.GDExtensionInitializationLevel(level))
+	if level == 2 {
+		loadRegistrations()
+		for _, fn := range gd.StartupFunctions {
+			fn()
+		}
+		close(intialized)
+		resume_main, stop_main = iter.Pull(call_main_in_steps())
+		resume_main()
+		if generation == 0 { // otherwise, anything added to the scene tree is restored.
+			for _, fn := range gd.PostStartupFunctions {
+				fn()
+			}
+		}
+	}
+}
+
+//go:wasmexport deinitialize
+func deinitialize(level uint32) {
+	if level == 2 {
+		gd.CancelEngineContext()
+		for _, cleanup := range gd.Cleanups() {
+			cleanup()
+		}
+		pointers.Cycle()
+		pointers.Cycle()
+		if theMainFunctionIsWaitingForTheEngineToShutDown {
+			resume_main()
+		}
+	}
+}
+
+// linkWASIP1 implements the Godot GDExtension API via the host.
+func linkWASIP1(API *gd.API) {
+	mem_alloc = dlsym("mem_alloc")
+	mem_free = dlsym("mem_free")
+	stack.base = mem_alloc.call(stack.size)
+	stack.used = 0
+	get_godot_version := dlsym("get_godot_version")
+	API.GetGodotVersion = func() gd.Version {
+		f := enter()
+		defer f.free()
+		r_version := ret[[3]uint64](&f)
+		get_godot_version.call(r_version)
+		version := load[[3]uint64](r_version)
 		return gd.Version{
-			Major: get_godot_version_major(),
-			Minor: get_godot_version_minor(),
-			Patch: get_godot_version_patch(),
+			Major: uint32(version[0]),
+			Minor: uint32(version[0] >> 32),
+			Patch: uint32(version[1]),
+			Value: cstring(version[2]),
+		}
+	}
+	API.Memory.Allocate = func(size uintptr) gd.Address {
+		return gd.Address(mem_alloc.call(uint64(size)))
+	}
+	mem_realloc := dlsym("mem_realloc")
+	API.Memory.Reallocate = func(ptr gd.Address, size uintptr) gd.Address {
+		return gd.Address(mem_realloc.call(uint64(ptr), uint64(size)))
+	}
+	API.Memory.Free = func(ptr gd.Address) {
+		mem_free.call(uint64(ptr))
+	}
+	var scratch [16]uint64
+	API.Memory.Index = func(frame gd.Address, index int, size uintptr) unsafe.Pointer {
+		if index < 0 {
+			return unsafe.Pointer(&scratch)
+		}
+		ptr := load[uint64](uint64(frame) + uint64(index)*8)
+		host_read(unsafe.Pointer(&scratch), ptr, uint32(size))
+		return unsafe.Pointer(&scratch)
+	}
+	API.Memory.Write = func(frame gd.Address, ptr unsafe.Pointer, size uintptr) {
+		host_write(uint64(frame), ptr, uint32(size))
+	}
+	print := func(fn proc) func(code, function, file string, line int32, notifyEditor bool) {
+		return func(code, function, file string, line int32, notifyEditor bool) {
+			f := enter()
+			defer f.free()
+			fn.call(f.cstring(code), f.cstring(function), f.cstring(file), uint64(line), b64(notifyEditor))
+		}
+	}
+	printMessage := func(fn proc) func(code, message, function, file string, line int32, notifyEditor bool) {
+		return func(code, message, function, file string, line int32, notifyEditor bool) {
+			f := enter()
+			defer f.free()
+			fn.call(f.cstring(code), f.cstring(message), f.cstring(function), f.cstring(file), uint64(line), b64(notifyEditor))
+		}
+	}
+	API.PrintError = print(dlsym("print_error"))
+	API.PrintErrorMessage = printMessage(dlsym("print_error_with_message"))
+	API.PrintWarning = print(dlsym("print_warning"))
+	API.PrintScriptError = print(dlsym("print_script_error"))
+	API.PrintScriptErrorMessage = printMessage(dlsym("print_script_error_with_message"))
+	get_native_struct_size := dlsym("get_native_struct_size")
+	API.GetNativeStructSize = func(name gd.StringName) uintptr {
+		f := enter()
+		defer f.free()
+		return uintptr(get_native_struct_size.call(arg(&f, pointers.Get(name))))
+	}
+	variant_new_copy := dlsym("variant_new_copy")
+	API.Variants.NewCopy = func(self gd.Variant) gd.Variant {
+		f := enter()
+		defer f.free()
+		r_dest := ret[[3]uint64](&f)
+		variant_new_copy.call(r_dest, arg(&f, pointers.Get(self)))
+		return pointers.New[gd.Variant](load[[3]uint64](r_dest))
+	}
+	variant_new_nil := dlsym("variant_new_nil")
+	API.Variants.NewNil = func() gd.Variant {
+		f := enter()
+		defer f.free()
+		r_dest := ret[[3]uint64](&f)
+		variant_new_nil.call(r_dest)
+		return pointers.New[gd.Variant](load[[3]uint64](r_dest))
+	}
+	variant_destroy := dlsym("variant_destroy")
+	API.Variants.Destroy = func(self gd.Variant) {
+		raw, ok := pointers.End(self)
+		if !ok {
+			return
+		}
+		f := enter()
+		defer f.free()
+		variant_destroy.call(arg(&f, raw))
+	}
+	variant_call := dlsym("variant_call")
+	API.Variants.Call = func(self gd.Variant, method gd.StringName, args ...gd.Variant) (gd.Variant, error) {
+		f := enter()
+		defer f.free()
+		r_ret := ret[[3]uint64](&f)
+		r_error := ret[[3]int32](&f)
+		variant_call.call(arg(&f, pointers.Get(self)), arg(&f, pointers.Get(method)), f.variants(args), uint64(len(args)), r_ret, r_error)
+		if err := callError(r_error); err != nil {
+			return gd.Variant{}, err
+		}
+		return pointers.New[gd.Variant](load[[3]uint64](r_ret)), nil
+	}
+	variant_call_static := dlsym("variant_call_static")
+	API.Variants.CallStatic = func(vtype gd.VariantType, method gd.StringName, args ...gd.Variant) (gd.Variant, error) {
+		f := enter()
+		defer f.free()
+		r_ret := ret[[3]uint64](&f)
+		r_error := ret[[3]int32](&f)
+		variant_call_static.call(uint64(vtype), arg(&f, pointers.Get(method)), f.variants(args), uint64(len(args)), r_ret, r_error)
+		if err := callError(r_error); err != nil {
+			return gd.Variant{}, err
+		}
+		return pointers.New[gd.Variant](load[[3]uint64](r_ret)), nil
+	}
+	variant_evaluate := dlsym("variant_evaluate")
+	API.Variants.Evaluate = func(operator gd.Operator, a, b gd.Variant) (gd.Variant, bool) {
+		f := enter()
+		defer f.free()
+		r_ret := ret[[3]uint64](&f)
+		r_valid := ret[bool](&f)
+		variant_evaluate.call(uint64(operator), arg(&f, pointers.Get(a)), arg(&f, pointers.Get(b)), r_ret, r_valid)
+		return pointers.New[gd.Variant](load[[3]uint64](r_ret)), load[bool](r_valid)
+	}
+	setter := func(fn proc) func(self gd.Variant, key [3]uint64, val gd.Variant) bool {
+		return func(self gd.Variant, key [3]uint64, val gd.Variant) bool {
+			f := enter()
+			defer f.free()
+			r_valid := ret[bool](&f)
+			fn.call(arg(&f, pointers.Get(self)), arg(&f, key), arg(&f, pointers.Get(val)), r_valid)
+			return load[bool](r_valid)
+		}
+	}
+	variant_set := setter(dlsym("variant_set"))
+	API.Variants.Set = func(self, key, val gd.Variant) bool {
+		return variant_set(self, pointers.Get(key), val)
+	}
+	variant_set_named := setter(dlsym("variant_set_named"))
+	API.Variants.SetNamed = func(self gd.Variant, key gd.StringName, val gd.Variant) bool {
+		return variant_set_named(self, [3]uint64{pointers.Get(key)[0]}, val)
+	}
+	variant_set_keyed := setter(dlsym("variant_set_keyed"))
+	API.Variants.SetKeyed = func(self, key, val gd.Variant) bool {
+		return variant_set_keyed(self, pointers.Get(key), val)
+	}
+	variant_set_indexed := dlsym("variant_set_indexed")
+	API.Variants.SetIndexed = func(self gd.Variant, index gd.Int, val gd.Variant) (bool, bool) {
+		f := enter()
+		defer f.free()
+		r_valid := ret[bool](&f)
+		r_oob := ret[bool](&f)
+		variant_set_indexed.call(arg(&f, pointers.Get(self)), uint64(index), arg(&f, pointers.Get(val)), r_valid, r_oob)
+		return load[bool](r_valid), load[bool](r_oob)
+	}
+	getter := func(fn proc) func(self gd.Variant, key [3]uint64) (gd.Variant, bool) {
+		return func(self gd.Variant, key [3]uint64) (gd.Variant, bool) {
+			f := enter()
+			defer f.free()
+			r_ret := ret[[3]uint64](&f)
+			r_valid := ret[bool](&f)
+			fn.call(arg(&f, pointers.Get(self)), arg(&f, key), r_ret, r_valid)
+			return pointers.New[gd.Variant](load[[3]uint64](r_ret)), load[bool](r_valid)
+		}
+	}
+	variant_get := getter(dlsym("variant_get"))
+	API.Variants.Get = func(self, key gd.Variant) (gd.Variant, bool) {
+		return variant_get(self, pointers.Get(key))
+	}
+	variant_get_named := getter(dlsym("variant_get_named"))
+	API.Variants.GetNamed = func(self gd.Variant, key gd.StringName) (gd.Variant, bool) {
+		return variant_get_named(self, [3]uint64{pointers.Get(key)[0]})
+	}
+	variant_get_keyed := getter(dlsym("variant_get_keyed"))
+	API.Variants.GetKeyed = func(self, key gd.Variant) (gd.Variant, bool) {
+		return variant_get_keyed(self, pointers.Get(key))
+	}
+	variant_get_indexed := dlsym("variant_get_indexed")
+	API.Variants.GetIndexed = func(self gd.Variant, index gd.Int) (gd.Variant, bool, bool) {
+		f := enter()
+		defer f.free()
+		r_ret := ret[[3]uint64](&f)
+		r_valid := ret[bool](&f)
+		r_oob := ret[bool](&f)
+		variant_get_indexed.call(arg(&f, pointers.Get(self)), uint64(index), r_ret, r_valid, r_oob)
+		return pointers.New[gd.Variant](load[[3]uint64](r_ret)), load[bool](r_valid), load[bool](r_oob)
+	}
+	variant_iter_init := dlsym("variant_iter_init")
+	API.Variants.IteratorInitialize = func(self gd.Variant) (gd.Variant, bool) {
+		f := enter()
+		defer f.free()
+		r_iter := ret[[3]uint64](&f)
+		r_valid := ret[bool](&f)
+		variant_iter_init.call(arg(&f, pointers.Get(self)), r_iter, r_valid)
+		return pointers.New[gd.Variant](load[[3]uint64](r_iter)), load[bool](r_valid)
+	}
+	variant_iter_next := dlsym("variant_iter_next")
+	API.Variants.IteratorNext = func(self gd.Variant, iter gd.Variant) bool {
+		f := enter()
+		defer f.free()
+		p_iter := arg(&f, pointers.Get(iter))
+		r_valid := ret[bool](&f)
+		variant_iter_next.call(arg(&f, pointers.Get(self)), p_iter, r_valid)
+		pointers.Set(iter, load[[3]uint64](p_iter))
+		return load[bool](r_valid)
+	}
+	variant_iter_get := dlsym("variant_iter_get")
+	API.Variants.IteratorGet = func(self, iter gd.Variant) (gd.Variant, bool) {
+		f := enter()
+		defer f.free()
+		r_ret := ret[[3]uint64](&f)
+		r_valid := ret[bool](&f)
+		variant_iter_get.call(arg(&f, pointers.Get(self)), arg(&f, pointers.Get(iter)), r_ret, r_valid)
+		return pointers.New[gd.Variant](load[[3]uint64](r_ret)), load[bool](r_valid)
+	}
+	variant_hash := dlsym("variant_hash")
+	API.Variants.Hash = func(self gd.Variant) gd.Int {
+		f := enter()
+		defer f.free()
+		return gd.Int(variant_hash.call(arg(&f, pointers.Get(self))))
+	}
+	variant_recursive_hash := dlsym("variant_recursive_hash")
+	API.Variants.RecursiveHash = func(self gd.Variant, count gd.Int) gd.Int {
+		f := enter()
+		defer f.free()
+		return gd.Int(variant_recursive_hash.call(arg(&f, pointers.Get(self)), uint64(count)))
+	}
+	variant_hash_compare := dlsym("variant_hash_compare")
+	API.Variants.HashCompare = func(self, other gd.Variant) bool {
+		f := enter()
+		defer f.free()
+		return uint8(variant_hash_compare.call(arg(&f, pointers.Get(self)), arg(&f, pointers.Get(other)))) != 0
+	}
+	variant_booleanize := dlsym("variant_booleanize")
+	API.Variants.Booleanize = func(self gd.Variant) bool {
+		f := enter()
+		defer f.free()
+		return uint8(variant_booleanize.call(arg(&f, pointers.Get(self)))) != 0
+	}
+	variant_duplicate := dlsym("variant_duplicate")
+	API.Variants.Duplicate = func(self gd.Variant, deep bool) gd.Variant {
+		f := enter()
+		defer f.free()
+		r_ret := ret[[3]uint64](&f)
+		variant_duplicate.call(arg(&f, pointers.Get(self)), r_ret, b64(deep))
+		return pointers.New[gd.Variant](load[[3]uint64](r_ret))
+	}
+	variant_stringify := dlsym("variant_stringify")
+	API.Variants.Stringify = func(self gd.Variant) gd.String {
+		f := enter()
+		defer f.free()
+		r_ret := ret[[1]gd.EnginePointer](&f)
+		variant_stringify.call(arg(&f, pointers.Get(self)), r_ret)
+		return pointers.New[gd.String](load[[1]gd.EnginePointer](r_ret))
+	}
+	variant_get_type := dlsym("variant_get_type")
+	API.Variants.GetType = func(self gd.Variant) gd.VariantType {
+		f := enter()
+		defer f.free()
+		return gd.VariantType(uint32(variant_get_type.call(arg(&f, pointers.Get(self)))))
+	}
+	variant_has_method := dlsym("variant_has_method")
+	API.Variants.HasMethod = func(self gd.Variant, method gd.StringName) bool {
+		f := enter()
+		defer f.free()
+		return uint8(variant_has_method.call(arg(&f, pointers.Get(self)), arg(&f, pointers.Get(method)))) != 0
+	}
+	variant_has_member := dlsym("variant_has_member")
+	API.Variants.HasMember = func(self gd.Variant, member gd.StringName) bool {
+		f := enter()
+		defer f.free()
+		return uint8(variant_has_member.call(uint64(API.Variants.GetType(self)), arg(&f, pointers.Get(member)))) != 0
+	}
+	variant_has_key := dlsym("variant_has_key")
+	API.Variants.HasKey = func(self gd.Variant, key gd.Variant) (bool, bool) {
+		f := enter()
+		defer f.free()
+		r_valid := ret[bool](&f)
+		has := uint8(variant_has_key.call(arg(&f, pointers.Get(self)), arg(&f, pointers.Get(key)), r_valid)) != 0
+		return has, load[bool](r_valid)
+	}
+	variant_get_type_name := dlsym("variant_get_type_name")
+	API.Variants.GetTypeName = func(vtype gd.VariantType) gd.String {
+		f := enter()
+		defer f.free()
+		r_ret := ret[[1]gd.EnginePointer](&f)
+		variant_get_type_name.call(uint64(vtype), r_ret)
+		return pointers.New[gd.String](load[[1]gd.EnginePointer](r_ret))
+	}
+	variant_can_convert := dlsym("variant_can_convert")
+	API.Variants.CanConvert = func(self gd.Variant, to gd.VariantType) bool {
+		return uint8(variant_can_convert.call(uint64(API.Variants.GetType(self)), uint64(to))) != 0
+	}
+	variant_can_convert_strict := dlsym("variant_can_convert_strict")
+	API.Variants.CanConvertStrict = func(self gd.Variant, to gd.VariantType) bool {
+		return uint8(variant_can_convert_strict.call(uint64(API.Variants.GetType(self)), uint64(to))) != 0
+	}
+	get_variant_from_type_constructor := dlsym("get_variant_from_type_constructor")
+	API.Variants.FromTypeConstructor = func(vt gd.VariantType) func(ret callframe.Ptr[gd.VariantPointers], arg callframe.Addr) {
+		fn := proc(get_variant_from_type_constructor.call(uint64(vt)))
+		return func(ret callframe.Ptr[gd.VariantPointers], arg callframe.Addr) {
+			f := enter()
+			defer f.free()
+			fn.call(f.addr(ret.Addr()), f.addr(arg))
+		}
+	}
+	get_variant_to_type_constructor := dlsym("get_variant_to_type_constructor")
+	API.Variants.ToTypeConstructor = func(vt gd.VariantType) func(ret callframe.Addr, arg callframe.Ptr[gd.VariantPointers]) {
+		fn := proc(get_variant_to_type_constructor.call(uint64(vt)))
+		return func(ret callframe.Addr, arg callframe.Ptr[gd.VariantPointers]) {
+			f := enter()
+			defer f.free()
+			fn.call(f.addr(ret), f.addr(arg.Addr()))
+		}
+	}
+	variant_get_ptr_operator_evaluator := dlsym("variant_get_ptr_operator_evaluator")
+	API.Variants.PointerOperatorEvaluator = func(op gd.Operator, a, b gd.VariantType) func(a, b, ret callframe.Addr) {
+		fn := proc(variant_get_ptr_operator_evaluator.call(uint64(op), uint64(a), uint64(b)))
+		return func(a, b, ret callframe.Addr) {
+			f := enter()
+			defer f.free()
+			fn.call(f.addr(a), f.addr(b), f.addr(ret))
+		}
+	}
+	variant_get_ptr_builtin_method := dlsym("variant_get_ptr_builtin_method")
+	API.Variants.GetPointerBuiltinMethod = func(vt gd.VariantType, sn gd.StringName, hash gd.Int) func(base callframe.Addr, args callframe.Args, ret callframe.Addr, c int32) {
+		f := enter()
+		fn := proc(variant_get_ptr_builtin_method.call(uint64(vt), arg(&f, pointers.Get(sn)), uint64(hash)))
+		f.free()
+		return func(base callframe.Addr, args callframe.Args, ret callframe.Addr, c int32) {
+			f := enter()
+			defer f.free()
+			fn.call(f.addr(base), f.args(args), f.addr(ret), uint64(c))
+		}
+	}
+	variant_get_ptr_constructor := dlsym("variant_get_ptr_constructor")
+	API.Variants.GetPointerConstructor = func(vt gd.VariantType, index int32) func(base callframe.Addr, args callframe.Args) {
+		fn := proc(variant_get_ptr_constructor.call(uint64(vt), uint64(index)))
+		return func(base callframe.Addr, args callframe.Args) {
+			f := enter()
+			defer f.free()
+			fn.call(f.addr(base), f.args(args))
+		}
+	}
+	variant_get_ptr_destructor := dlsym("variant_get_ptr_destructor")
+	API.Variants.GetPointerDestructor = func(vt gd.VariantType) func(base callframe.Addr) {
+		fn := proc(variant_get_ptr_destructor.call(uint64(vt)))
+		return func(base callframe.Addr) {
+			f := enter()
+			defer f.free()
+			fn.call(f.addr(base))
+		}
+	}
+	variant_construct := dlsym("variant_construct")
+	API.Variants.Construct = func(t gd.VariantType, args ...gd.Variant) (gd.Variant, error) {
+		f := enter()
+		defer f.free()
+		r_ret := ret[[3]uint64](&f)
+		r_error := ret[[3]int32](&f)
+		variant_construct.call(uint64(t), r_ret, f.variants(args), uint64(len(args)), r_error)
+		if err := callError(r_error); err != nil {
+			return gd.Variant{}, err
+		}
+		return pointers.New[gd.Variant](load[[3]uint64](r_ret)), nil
+	}
+	variant_get_ptr_setter := dlsym("variant_get_ptr_setter")
+	API.Variants.GetPointerSetter = func(vt gd.VariantType, sn gd.StringName) func(base, arg callframe.Addr) {
+		f := enter()
+		fn := proc(variant_get_ptr_setter.call(uint64(vt), arg(&f, pointers.Get(sn))))
+		f.free()
+		return func(base, arg callframe.Addr) {
+			f := enter()
+			defer f.free()
+			fn.call(f.addr(base), f.addr(arg))
+		}
+	}
+	variant_get_ptr_getter := dlsym("variant_get_ptr_getter")
+	API.Variants.GetPointerGetter = func(vt gd.VariantType, sn gd.StringName) func(base, ret callframe.Addr) {
+		f := enter()
+		fn := proc(variant_get_ptr_getter.call(uint64(vt), arg(&f, pointers.Get(sn))))
+		f.free()
+		return func(base, ret callframe.Addr) {
+			f := enter()
+			defer f.free()
+			fn.call(f.addr(base), f.addr(ret))
+		}
+	}
+	variant_get_ptr_indexed_setter := dlsym("variant_get_ptr_indexed_setter")
+	API.Variants.GetPointerIndexedSetter = func(vt gd.VariantType) func(base callframe.Addr, index gd.Int, arg callframe.Addr) {
+		fn := proc(variant_get_ptr_indexed_setter.call(uint64(vt)))
+		return func(base callframe.Addr, index gd.Int, arg callframe.Addr) {
+			f := enter()
+			defer f.free()
+			fn.call(f.addr(base), uint64(index), f.addr(arg))
+		}
+	}
+	variant_get_ptr_indexed_getter := dlsym("variant_get_ptr_indexed_getter")
+	API.Variants.GetPointerIndexedGetter = func(vt gd.VariantType) func(base callframe.Addr, index gd.Int, ret callframe.Addr) {
+		fn := proc(variant_get_ptr_indexed_getter.call(uint64(vt)))
+		return func(base callframe.Addr, index gd.Int, ret callframe.Addr) {
+			f := enter()
+			defer f.free()
+			fn.call(f.addr(base), uint64(index), f.addr(ret))
+		}
+	}
+	variant_get_ptr_keyed_setter := dlsym("variant_get_ptr_keyed_setter")
+	API.Variants.GetPointerKeyedSetter = func(vt gd.VariantType) func(base, key, arg callframe.Addr) {
+		fn := proc(variant_get_ptr_keyed_setter.call(uint64(vt)))
+		return func(base, key, arg callframe.Addr) {
+			f := enter()
+			defer f.free()
+			fn.call(f.addr(base), f.addr(key), f.addr(arg))
+		}
+	}
+	variant_get_ptr_keyed_getter := dlsym("variant_get_ptr_keyed_getter")
+	API.Variants.GetPointerKeyedGetter = func(vt gd.VariantType) func(base, key, ret callframe.Addr) {
+		fn := proc(variant_get_ptr_keyed_getter.call(uint64(vt)))
+		return func(base, key, ret callframe.Addr) {
+			f := enter()
+			defer f.free()
+			fn.call(f.addr(base), f.addr(key), f.addr(ret))
+		}
+	}
+	variant_get_ptr_keyed_checker := dlsym("variant_get_ptr_keyed_checker")
+	API.Variants.GetPointerKeyedChecker = func(vt gd.VariantType) func(base, key callframe.Addr) uint32 {
+		fn := proc(variant_get_ptr_keyed_checker.call(uint64(vt)))
+		return func(base, key callframe.Addr) uint32 {
+			f := enter()
+			defer f.free()
+			return uint32(fn.call(f.addr(base), f.addr(key)))
+		}
+	}
+	variant_get_constant_value := dlsym("variant_get_constant_value")
+	API.Variants.GetConstantValue = func(vt gd.VariantType, sn gd.StringName) gd.Variant {
+		f := enter()
+		defer f.free()
+		r_ret := ret[[3]uint64](&f)
+		variant_get_constant_value.call(uint64(vt), arg(&f, pointers.Get(sn)), r_ret)
+		return pointers.New[gd.Variant](load[[3]uint64](r_ret))
+	}
+	variant_get_ptr_utility_function := dlsym("variant_get_ptr_utility_function")
+	API.Variants.GetPointerUtilityFunction = func(sn gd.StringName, hash gd.Int) func(ret callframe.Addr, args callframe.Args, c int32) {
+		f := enter()
+		fn := proc(variant_get_ptr_utility_function.call(arg(&f, pointers.Get(sn)), uint64(hash)))
+		f.free()
+		return func(ret callframe.Addr, args callframe.Args, c int32) {
+			f := enter()
+			defer f.free()
+			fn.call(f.addr(ret), f.args(args), uint64(c))
+		}
+	}
+	string_new_with_utf8_chars_and_len := dlsym("string_new_with_utf8_chars_and_len")
+	API.Strings.New = func(s string) gd.String {
+		f := enter()
+		defer f.free()
+		r_ret := ret[[1]gd.EnginePointer](&f)
+		string_new_with_utf8_chars_and_len.call(r_ret, f.cstring(s), uint64(len(s)))
+		return pointers.New[gd.String](load[[1]gd.EnginePointer](r_ret))
+	}
+	string_to_utf8_chars := dlsym("string_to_utf8_chars")
+	API.Strings.Get = func(s gd.String) string {
+		var length = s.Length()
+		if length == 0 {
+			return ""
+		}
+		f := enter()
+		defer f.free()
+		buf := make([]byte, length)
+		r_text := f.alloc(uint64(length))
+		string_to_utf8_chars.call(arg(&f, pointers.Get(s)), r_text, uint64(length))
+		host_read(unsafe.Pointer(&buf[0]), r_text, uint32(length))
+		return string(buf)
+	}
+	string_operator_index := dlsym("string_operator_index")
+	API.Strings.SetIndex = func(s gd.String, index gd.Int, val rune) {
+		f := enter()
+		defer f.free()
+		store(string_operator_index.call(arg(&f, pointers.Get(s)), uint64(index)), val)
+	}
+	string_operator_index_const := dlsym("string_operator_index_const")
+	API.Strings.Index = func(s gd.String, index gd.Int) rune {
+		f := enter()
+		defer f.free()
+		return load[rune](string_operator_index_const.call(arg(&f, pointers.Get(s)), uint64(index)))
+	}
+	string_operator_plus_eq_string := dlsym("string_operator_plus_eq_string")
+	API.Strings.Append = func(s gd.String, other gd.String) {
+		f := enter()
+		defer f.free()
+		p_self := arg(&f, pointers.Get(s))
+		string_operator_plus_eq_string.call(p_self, arg(&f, pointers.Get(other)))
+		pointers.Set(s, load[[1]gd.EnginePointer](p_self))
+	}
+	string_operator_plus_eq_char := dlsym("string_operator_plus_eq_char")
+	API.Strings.AppendRune = func(s gd.String, other rune) {
+		f := enter()
+		defer f.free()
+		p_self := arg(&f, pointers.Get(s))
+		string_operator_plus_eq_char.call(p_self, uint64(other))
+		pointers.Set(s, load[[1]gd.EnginePointer](p_self))
+	}
+	string_resize := dlsym("string_resize")
+	API.Strings.Resize = func(s gd.String, size gd.Int) {
+		f := enter()
+		defer f.free()
+		p_self := arg(&f, pointers.Get(s))
+		var length = s.Length()
+		string_resize.call(p_self, uint64(size))
+		pointers.Set(s, load[[1]gd.EnginePointer](p_self))
+		if size < length {
+			API.Strings.SetIndex(s, size, 0)
+		}
+	}
+	string_name_new_with_utf8_chars_and_len := dlsym("string_name_new_with_utf8_chars_and_len")
+	API.StringNames.New = func(s string) gd.StringName {
+		f := enter()
+		defer f.free()
+		r_ret := ret[[1]gd.EnginePointer](&f)
+		string_name_new_with_utf8_chars_and_len.call(r_ret, f.cstring(s), uint64(len(s)))
+		return pointers.New[gd.StringName](load[[1]gd.EnginePointer](r_ret))
+	}
+	xml_parser_open_buffer := dlsym("xml_parser_open_buffer")
+	API.XMLParser.OpenBuffer = func(x gd.Object, b []byte) error {
+		f := enter()
+		defer f.free()
+		// the parser reads from the buffer for as long as it is open, so the buffer is
+		// copied into engine memory that is never released.
+		buf := mem_alloc.call(uint64(len(b)))
+		host_write(buf, unsafe.Pointer(&b[0]), uint32(len(b)))
+		if uint32(xml_parser_open_buffer.call(arg(&f, pointers.Get(x)), buf, uint64(len(b)))) != 0 {
+			return errors.New("xml_parser_open_buffer failed")
+		}
+		return nil
+	}
+	file_access_store_buffer := dlsym("file_access_store_buffer")
+	API.FileAccess.StoreBuffer = func(file gd.Object, b []byte) {
+		f := enter()
+		defer f.free()
+		file_access_store_buffer.call(arg(&f, pointers.Get(file)), f.bytes(b), uint64(len(b)))
+	}
+	file_access_get_buffer := dlsym("file_access_get_buffer")
+	API.FileAccess.GetBuffer = func(file gd.Object, b []byte) int {
+		f := enter()
+		defer f.free()
+		buf := f.alloc(uint64(len(b)))
+		length := file_access_get_buffer.call(arg(&f, pointers.Get(file)), buf, uint64(len(b)))
+		if length > 0 {
+			host_read(unsafe.Pointer(&b[0]), buf, uint32(length))
+		}
+		return int(length)
+	}
+	API.PackedByteArray = makePackedFunctions[gd.PackedByteArray, byte]("byte_array")
+	API.PackedColorArray = makePackedFunctions[gd.PackedColorArray, gd.Color]("color_array")
+	API.PackedFloat32Array = makePackedFunctions[gd.PackedFloat32Array, float32]("float32_array")
+	API.PackedFloat64Array = makePackedFunctions[gd.PackedFloat64Array, float64]("float64_array")
+	API.PackedInt32Array = makePackedFunctions[gd.PackedInt32Array, int32]("int32_array")
+	API.PackedInt64Array = makePackedFunctions[gd.PackedInt64Array, int64]("int64_array")
+	packed_string_array_operator_index_const := dlsym("packed_string_array_operator_index_const")
+	API.PackedStringArray.Index = func(psa gd.PackedStringArray, i gd.Int) gd.String {
+		f := enter()
+		defer f.free()
+		ptr := packed_string_array_operator_index_const.call(arg(&f, pointers.Get(psa)), uint64(i))
+		return pointers.Let[gd.String](load[[1]gd.EnginePointer](ptr))
+	}
+	packed_string_array_operator_index := dlsym("packed_string_array_operator_index")
+	API.PackedStringArray.SetIndex = func(psa gd.PackedStringArray, i gd.Int, v gd.String) {
+		f := enter()
+		defer f.free()
+		ptr := packed_string_array_operator_index.call(arg(&f, pointers.Get(psa)), uint64(i))
+		store(ptr, pointers.Get(v))
+	}
+	API.PackedVector2Array = makePackedFunctions[gd.PackedVector2Array, gd.Vector2]("vector2_array")
+	API.PackedVector3Array = makePackedFunctions[gd.PackedVector3Array, gd.Vector3]("vector3_array")
+	API.PackedVector4Array = makePackedFunctions[gd.PackedVector4Array, gd.Vector4]("vector4_array")
+	array_operator_index_const := dlsym("array_operator_index_const")
+	API.Array.Index = func(a gd.Array, i gd.Int) gd.Variant {
+		f := enter()
+		defer f.free()
+		ptr := array_operator_index_const.call(arg(&f, pointers.Get(a)), uint64(i))
+		return pointers.Let[gd.Variant](load[[3]uint64](ptr)).Copy()
+	}
+	array_operator_index := dlsym("array_operator_index")
+	API.Array.SetIndex = func(a gd.Array, i gd.Int, v gd.Variant) {
+		f := enter()
+		defer f.free()
+		ptr := array_operator_index.call(arg(&f, pointers.Get(a)), uint64(i))
+		p_copy := ret[[3]uint64](&f)
+		variant_new_copy.call(p_copy, arg(&f, pointers.Get(v)))
+		store(ptr, load[[3]uint64](p_copy))
+	}
+	array_ref := dlsym("array_ref")
+	API.Array.Set = func(self gd.Array, from gd.Array) {
+		f := enter()
+		defer f.free()
+		array_ref.call(arg(&f, pointers.Get(self)), arg(&f, pointers.Get(from)))
+	}
+	array_set_typed := dlsym("array_set_typed")
+	API.Array.SetTyped = func(self gd.Array, t gd.VariantType, className gd.StringName, script gd.Object) {
+		f := enter()
+		defer f.free()
+		array_set_typed.call(arg(&f, pointers.Get(self)), uint64(t), arg(&f, pointers.Get(className)), arg(&f, pointers.Get(script)))
+	}
+	dictionary_set_typed := dlsym("dictionary_set_typed") // Godot 4.4+
+	if dictionary_set_typed != 0 {
+		API.Dictionary.SetTyped = func(self gd.Dictionary, key gd.VariantType, keyClassName gd.StringName, keyScript gd.Object, val gd.VariantType, valClassName gd.StringName, valScript gd.Object) {
+			f := enter()
+			defer f.free()
+			dictionary_set_typed.call(arg(&f, pointers.Get(self)),
+				uint64(key), arg(&f, pointers.Get(keyClassName)), arg(&f, pointers.Get(keyScript)),
+				uint64(val), arg(&f, pointers.Get(valClassName)), arg(&f, pointers.Get(valScript)),
+			)
+		}
+	}
+	dictionary_operator_index := dlsym("dictionary_operator_index")
+	API.Dictionary.Index = func(d gd.Dictionary, key gd.Variant) gd.Variant {
+		f := enter()
+		defer f.free()
+		ptr := dictionary_operator_index.call(arg(&f, pointers.Get(d)), arg(&f, pointers.Get(key)))
+		return pointers.Let[gd.Variant](load[[3]uint64](ptr)).Copy()
+	}
+	API.Dictionary.SetIndex = func(d gd.Dictionary, key, val gd.Variant) {
+		f := enter()
+		defer f.free()
+		ptr := dictionary_operator_index.call(arg(&f, pointers.Get(d)), arg(&f, pointers.Get(key)))
+		p_copy := ret[[3]uint64](&f)
+		variant_new_copy.call(p_copy, arg(&f, pointers.Get(val)))
+		store(ptr, load[[3]uint64](p_copy))
+	}
+	object_get_instance_from_id := dlsym("object_get_instance_from_id")
+	API.Object.GetInstanceFromID = func(id gd.ObjectID) [1]gd.Object {
+		ptr := object_get_instance_from_id.call(uint64(id))
+		if ptr == 0 {
+			return [1]gd.Object{}
+		}
+		return [1]gd.Object{gd.PointerMustAssertInstanceID[gd.Object](gd.EnginePointer(ptr))}
+	}
+	// self returns the engine pointer for the object, after checking that it is still alive.
+	self := func(obj [1]gd.Object) uint64 {
+		raw := pointers.Get(obj[0])
+		if raw[0] == 0 {
+			panic("nil gd.Object dereference")
+		}
+		if raw[1] != 0 && object_get_instance_from_id.call(raw[1]) == 0 {
+			panic("use after free")
+		}
+		return raw[0]
+	}
+	object_method_bind_call := dlsym("object_method_bind_call")
+	API.Object.MethodBindCall = func(method gd.MethodBind, obj [1]gd.Object, args ...gd.Variant) (gd.Variant, error) {
+		raw := pointers.Get(obj[0])
+		if raw[0] == 0 {
+			return gd.Variant{}, errors.New("nil gd.Object dereference")
+		}
+		if raw[1] != 0 && object_get_instance_from_id.call(raw[1]) == 0 {
+			return gd.Variant{}, errors.New("use after free")
+		}
+		f := enter()
+		defer f.free()
+		r_ret := ret[[3]uint64](&f)
+		r_error := ret[[3]int32](&f)
+		object_method_bind_call.call(uint64(method), raw[0], f.variants(args), uint64(len(args)), r_ret, r_error)
+		if err := callError(r_error); err != nil {
+			return gd.Variant{}, err
+		}
+		return pointers.New[gd.Variant](load[[3]uint64](r_ret)), nil
+	}
+	object_method_bind_ptrcall := dlsym("object_method_bind_ptrcall")
+	API.Object.MethodBindPointerCall = func(method gd.MethodBind, obj [1]gd.Object, args callframe.Args, ret callframe.Addr) {
+		if obj == ([1]gd.Object{}) {
+			panic("nil gd.Object dereference")
+		}
+		p_self := self(obj)
+		f := enter()
+		defer f.free()
+		object_method_bind_ptrcall.call(uint64(method), p_self, f.args(args), f.addr(ret))
+	}
+	API.Object.MethodBindPointerCallStatic = func(method gd.MethodBind, args callframe.Args, ret callframe.Addr) {
+		f := enter()
+		defer f.free()
+		object_method_bind_ptrcall.call(uint64(method), 0, f.args(args), f.addr(ret))
+	}
+	object_destroy := dlsym("object_destroy")
+	API.Object.Destroy = func(obj [1]gd.Object) {
+		if obj == ([1]gd.Object{}) {
+			panic("nil gd.Object dereference")
+		}
+		object_destroy.call(self(obj))
+	}
+	global_get_singleton := dlsym("global_get_singleton")
+	API.Object.GetSingleton = func(name gd.StringName) [1]gd.Object {
+		f := enter()
+		defer f.free()
+		return [1]gd.Object{pointers.Raw[gd.Object]([3]uint64{global_get_singleton.call(arg(&f, pointers.Get(name)))})}
+	}
+	object_get_instance_binding := dlsym("object_get_instance_binding")
+	API.Object.GetInstanceBinding = func(obj [1]gd.Object, token gd.ExtensionToken, ibt gd.InstanceBindingType) any {
+		binding := object_get_instance_binding.call(self(obj), uint64(token), 0)
+		value, _ := handles.Load(uintptr(binding)) // bindings from a previous generation are lost.
+		return value
+	}
+	object_set_instance_binding := dlsym("object_set_instance_binding")
+	API.Object.SetInstanceBinding = func(obj [1]gd.Object, token gd.ExtensionToken, val any, ibt gd.InstanceBindingType) {
+		p_self := self(obj)
+		f := enter()
+		defer f.free()
+		object_set_instance_binding.call(p_self, uint64(token), uint64(cgoNewHandle(val)), ret[[3]uint64](&f))
+	}
+	object_free_instance_binding := dlsym("object_free_instance_binding")
+	API.Object.FreeInstanceBinding = func(obj [1]gd.Object, token gd.ExtensionToken) {
+		object_free_instance_binding.call(self(obj), uint64(token))
+	}
+	object_set_instance := dlsym("object_set_instance")
+	API.Object.SetInstance = func(obj [1]gd.Object, sn gd.StringName, oi gd.ObjectInterface) {
+		p_self := self(obj)
+		instances[p_self] = instance{oi, sn.String()}
+		f := enter()
+		defer f.free()
+		object_set_instance.call(p_self, arg(&f, pointers.Get(sn)), p_self)
+	}
+	object_get_class_name := dlsym("object_get_class_name")
+	API.Object.GetClassName = func(obj [1]gd.Object, token gd.ExtensionToken) gd.String {
+		p_self := self(obj)
+		f := enter()
+		defer f.free()
+		r_ret := ret[[1]gd.EnginePointer](&f)
+		object_get_class_name.call(p_self, uint64(token), r_ret)
+		return pointers.New[gd.String](load[[1]gd.EnginePointer](r_ret))
+	}
+	object_cast_to := dlsym("object_cast_to")
+	API.Object.CastTo = func(obj [1]gd.Object, tag gd.ClassTag) [1]gd.Object {
+		if pointers.Get(obj[0])[0] == 0 {
+			return [1]gd.Object{}
+		}
+		if object_cast_to.call(self(obj), uint64(tag)) == 0 {
+			return [1]gd.Object{}
+		}
+		return obj
+	}
+	object_get_instance_id := dlsym("object_get_instance_id")
+	API.Object.GetInstanceID = func(obj [1]gd.Object) gd.ObjectID {
+		return gd.ObjectID(object_get_instance_id.call(self(obj)))
+	}
+	ref_get_object := dlsym("ref_get_object")
+	API.RefCounted.GetObject = func(rc [1]gd.Object) [1]gd.Object {
+		return [1]gd.Object{pointers.New[gd.Object]([3]uint64{ref_get_object.call(self(rc))})}
+	}
+	ref_set_object := dlsym("ref_set_object")
+	API.RefCounted.SetObject = func(rc [1]gd.Object, obj [1]gd.Object) {
+		ref_set_object.call(pointers.Get(rc[0])[0], pointers.Get(obj[0])[0])
+	}
+	classdb_construct_object := dlsym("classdb_construct_object")
+	API.ClassDB.ConstructObject = func(name gd.StringName) [1]gd.Object {
+		f := enter()
+		defer f.free()
+		return [1]gd.Object{pointers.New[gd.Object]([3]uint64{classdb_construct_object.call(arg(&f, pointers.Get(name)))})}
+	}
+	classdb_get_class_tag := dlsym("classdb_get_class_tag")
+	API.ClassDB.GetClassTag = func(name gd.StringName) gd.ClassTag {
+		f := enter()
+		defer f.free()
+		return gd.ClassTag(classdb_get_class_tag.call(arg(&f, pointers.Get(name))))
+	}
+	classdb_get_method_bind := dlsym("classdb_get_method_bind")
+	API.ClassDB.GetMethodBind = func(class, method gd.StringName, hash gd.Int) gd.MethodBind {
+		f := enter()
+		defer f.free()
+		return gd.MethodBind(classdb_get_method_bind.call(arg(&f, pointers.Get(class)), arg(&f, pointers.Get(method)), uint64(hash)))
+	}
+	get_library_path := dlsym("get_library_path")
+	API.GetLibraryPath = func(token gd.ExtensionToken) gd.String {
+		f := enter()
+		defer f.free()
+		r_ret := ret[[1]gd.EnginePointer](&f)
+		get_library_path.call(uint64(token), r_ret)
+		return pointers.New[gd.String](load[[1]gd.EnginePointer](r_ret))
+	}
+	callable_custom_create := dlsym("callable_custom_create")
+	API.Callables.Create = func(fn func(...gd.Variant) (gd.Variant, error)) gd.Callable {
+		f := enter()
+		defer f.free()
+		r_callable := ret[[2]uint64](&f)
+		var info [10]uint64 // GDExtensionCallableCustomInfo
+		info[0] = uint64(cgoNewHandle(fn))
+		info[1] = uint64(library)
+		info[3] = host_callback(slotCallableCall)
+		callable_custom_create.call(r_callable, arg(&f, info))
+		return pointers.New[gd.Callable](load[[2]uint64](r_callable))
+	}
+	linkClassDB(API)
+	editor_add_plugin := dlsym("editor_add_plugin")
+	API.EditorPlugins.Add = func(plugin gd.StringName) {
+		if !register("plugin " + plugin.String()) {
+			return
+		}
+		f := enter()
+		defer f.free()
+		editor_add_plugin.call(arg(&f, pointers.Get(plugin)))
+	}
+	editor_remove_plugin := dlsym("editor_remove_plugin")
+	API.EditorPlugins.Remove = func(plugin gd.StringName) {
+		f := enter()
+		defer f.free()
+		editor_remove_plugin.call(arg(&f, pointers.Get(plugin)))
+	}
+	editor_help_load_xml_from_utf8_chars_and_len := dlsym("editor_help_load_xml_from_utf8_chars_and_len")
+	API.EditorHelp.Load = func(data []byte) {
+		f := enter()
+		defer f.free()
+		editor_help_load_xml_from_utf8_chars_and_len.call(f.bytes(data), uint64(len(data)))
+	}
+}
+
+func makePackedFunctions[T gd.Packed[T, V], V Packed.Type](prefix string) gd.PackedFunctionsFor[T, V] {
+	var API gd.PackedFunctionsFor[T, V]
+	packed_T_operator_index := dlsym("packed_" + prefix + "_operator_index")
+	API.SetIndex = func(t T, i gd.Int, v V) {
+		f := enter()
+		defer f.free()
+		store(packed_T_operator_index.call(arg(&f, pointers.Get[T, [2]uint64](t)), uint64(i)), v)
+	}
+	packed_T_operator_index_const := dlsym("packed_" + prefix + "_operator_index_const")
+	API.Index = func(t T, i gd.Int) V {
+		f := enter()
+		defer f.free()
+		return load[V](packed_T_operator_index_const.call(arg(&f, pointers.Get[T, [2]uint64](t)), uint64(i)))
+	}
+	API.CopyAsSlice = func(t T) []V {
+		var size = t.Len()
+		if size == 0 {
+			return nil
+		}
+		f := enter()
+		defer f.free()
+		ptr := packed_T_operator_index_const.call(arg(&f, pointers.Get[T, [2]uint64](t)), 0)
+		var slice = make([]V, size)
+		host_read(unsafe.Pointer(&slice[0]), ptr, uint32(uintptr(size)*unsafe.Sizeof(slice[0])))
+		return slice
+	}
+	API.CopyFromSlice = func(t T, slice []V) {
+		var size = min(t.Len(), len(slice))
+		if size == 0 {
+			return
 		}
+		f := enter()
+		defer f.free()
+		ptr := packed_T_operator_index.call(arg(&f, pointers.Get[T, [2]uint64](t)), 0)
+		host_write(ptr, unsafe.Pointer(&slice[0]), uint32(uintptr(size)*unsafe.Sizeof(slice[0])))
 	}
-	fmt.Println("Godot Version: ", gd.Global.GetGodotVersion().Major)
+	return API
 }
@@ -28,14 +28,23 @@ type functionCall struct {
 }
 
 // queue of functions to call later.
-var queue = Array.New[functionCall]()
+var (
+	queue      = Array.New[functionCall]()
+	queueMutex sync.Mutex
+)
 
 // Cycle calls all functions in the defer queue.
 func Cycle() {
+	queueMutex.Lock()
+	var calls = make([]functionCall, 0, queue.Len())
 	for _, queued := range queue.Iter() {
-		queued.function.Call(queued.arguments...)
+		calls = append(calls, queued)
 	}
 	Array.Clear(queue)
+	queueMutex.Unlock()
+	for _, queued := range calls {
+		queued.function.Call(queued.arguments...)
+	}
 }
 
 // New returns a new [Func] from the given value, if the value is not a Go func
@@ -140,11 +149,14 @@ func (fn Function) Call(args ...variant.Any) variant.Any { //gd:Callable.call Ca
 }
 
 // Defer calls the function represented by this callable at the end of the current frame.
-// Arguments can be passed and should match the method's signature.
+// Arguments can be passed and should match the method's signature. Defer is safe to
+// call from any goroutine.
 func Defer(fn Function, args ...variant.Any) { //gd:Callable.call_deferred
 	if fn == Nil {
 		return
 	}
+	queueMutex.Lock()
+	defer queueMutex.Unlock()
 	queue.Append(functionCall{
 		function:  fn,
 		arguments: args,
@@ -6,11 +6,24 @@
 //	}]
 //
 //	var MyEnums = Enum.Values[MyEnum]()
+//
+// Bitfields, where each value is a flag that can be combined with the others,
+// are defined with Bits.
+//
+//	type MyFlags Enum.Bits[struct {
+//		A MyFlags `gd:"A"`
+//		B MyFlags `gd:"B"`
+//	}]
+//
+//	var Flags = Enum.Flags[MyFlags]()
+//
+//	Enum.Or(Flags.A, Flags.B)
 package Enum
 
 import (
 	"errors"
 	"reflect"
+	"strings"
 
 	"graphics.gd/variant/String"
 )
@@ -79,6 +92,110 @@ func (m *methods[V]) UnmarshalText(text []byte) error {
 	return errors.New("invalid enum value: " + string(text))
 }
 
+// Bits is an enum of bit flags backed by increasing powers of two (1, 2, 4...), such that the values
+// can be combined together with [Or]. T should be a struct with fields of the defined type.
+//
+//	type MyFlags Enum.Bits[struct {
+//		Fire  MyFlags
+//		Water MyFlags
+//	}]
+//
+//	var Flags = Enum.Flags[MyFlags]()
+//
+//	var both = Enum.Or(Flags.Fire, Flags.Water)
+type Bits[T any] struct {
+	bits[T]
+}
+
+// Bitfield is implemented by [Bits] enums.
+type Bitfield interface {
+	Any
+
+	IsBitfield() bool
+}
+
+type bits[V any] int
+
+func (b bits[V]) Int() int         { return int(b) }
+func (b *bits[V]) SetInt(i int)    { *b = bits[V](i) }
+func (b bits[V]) IsBitfield() bool { return true }
+func (b bits[V]) Enum(yield func(string, int) bool) {
+	rtype := reflect.TypeFor[V]()
+	for i := 0; i < rtype.NumField(); i++ {
+		field := rtype.Field(i)
+		name, ok := field.Tag.Lookup("gd")
+		if !ok {
+			name = String.ToUpper(String.ToSnakeCase(field.Name))
+		}
+		if !yield(name, 1<<i) {
+			break
+		}
+	}
+}
+
+// Has returns true if all of the bits of flag are set.
+func (b bits[V]) Has(flag interface{ Int() int }) bool {
+	return int(b)&flag.Int() == flag.Int()
+}
+
+// String returns the names of the flags that are set, separated by '|'.
+func (b bits[V]) String() string {
+	var result string
+	for name, value := range b.Enum {
+		if int(b)&value == value {
+			if result != "" {
+				result += "|"
+			}
+			result += name
+		}
+	}
+	return result
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (b bits[V]) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, the flags are separated by '|'.
+func (b *bits[V]) UnmarshalText(text []byte) error {
+	var result bits[V]
+	for _, flag := range strings.Split(string(text), "|") {
+		flag = strings.TrimSpace(flag)
+		if flag == "" {
+			continue
+		}
+		var found bool
+		for name, value := range b.Enum {
+			if name == flag {
+				result |= bits[V](value)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.New("invalid enum flag: " + flag)
+		}
+	}
+	*b = result
+	return nil
+}
+
+type isBits[T any] interface {
+	~struct {
+		bits[T]
+	}
+}
+
+// Or returns the combination of the given flags.
+func Or[T isBits[V], V any](flags ...T) T {
+	var result bits[V]
+	for _, flag := range flags {
+		result |= struct{ bits[V] }(flag).bits
+	}
+	return T(struct{ bits[V] }{result})
+}
+
 type isEnum[T any] interface {
 	~struct {
 		methods[T]
@@ -94,3 +211,13 @@ func Values[T isEnum[V], V any]() V {
 	}
 	return values
 }
+
+// Flags returns the available flags for a [Bits] enum. It should be stored and reused inside a global variable.
+func Flags[T isBits[V], V any]() V {
+	var values V
+	rvalue := reflect.ValueOf(&values).Elem()
+	for i := 0; i < rvalue.NumField(); i++ {
+		rvalue.Field(i).Set(reflect.ValueOf(T(struct{ bits[V] }{bits[V](1 << i)})).Convert(rvalue.Field(i).Type()))
+	}
+	return values
+}
@@ -97,8 +97,8 @@ func (signal *localFirst) Remove(_ complex128, fn Callable.Function) {
 
 func (signal *localFirst) Emit(_ complex128, values ...variant.Any) {
 	signal.mutex.RLock()
-	defer signal.mutex.RUnlock()
 	if signal.proxy != nil {
+		signal.mutex.RUnlock()
 		signal.proxy.Emit(signal.state, values...)
 		return
 	}
@@ -115,6 +115,10 @@ func (signal *localFirst) Emit(_ complex128, values ...variant.Any) {
 			removes = append(removes, consumer.Callable)
 		}
 	}
+	signal.mutex.RUnlock()
+	for _, fn := range removes {
+		signal.Remove(0, fn)
+	}
 }
 
 func (signal *localFirst) Name(_ complex128) String.Readable {
@@ -49,8 +49,8 @@ const (
 // If the signal is already connected, returns [Error.InvalidParameter]
 func (signal *Any) Attach(consumer Callable.Function, flags ...Flags) error { //gd:Signal.connect
 	var f Flags
-	for _, f := range flags {
-		f |= f
+	for _, flag := range flags {
+		f |= flag
 	}
 	if signal.proxy == nil {
 		signal.proxy = &localFirst{}
@@ -0,0 +1,86 @@
+package Signal
+
+import (
+	"context"
+	"sync"
+
+	"graphics.gd/variant/Callable"
+)
+
+// Option configures a [Subscription], either [Flags] or a [Context].
+type Option interface {
+	option()
+}
+
+func (Flags) option() {}
+
+type contextOption struct{ ctx context.Context }
+
+func (contextOption) option() {}
+
+// Context returns an [Option] that closes the [Subscription] once ctx is done.
+func Context(ctx context.Context) Option { return contextOption{ctx} }
+
+// Subscription is a handle to a consumer of a signal, close it to remove the consumer.
+type Subscription struct {
+	state *subscription
+}
+
+type subscription struct {
+	once       sync.Once
+	disconnect func()
+	stop       []func() bool // of each context.AfterFunc
+}
+
+// Close removes the consumer from the signal, it is safe to call more than once, or after
+// a [OneShot] consumer has already been removed by the signal. Close must be called from
+// the main thread.
+func (sub Subscription) Close() {
+	if sub.state == nil {
+		return
+	}
+	sub.state.once.Do(func() {
+		for _, stop := range sub.state.stop {
+			stop()
+		}
+		sub.state.disconnect()
+	})
+}
+
+// Subscribe calls connect with the [Flags] in options and returns a [Subscription] that
+// calls disconnect when it is closed. If options include a [Context], the subscription is
+// closed on the main thread once the context is done. Subscribe is intended for packages
+// that connect consumers to signals, see [Any.Subscribe].
+func Subscribe(connect func(Flags), disconnect func(), options ...Option) Subscription {
+	var flags Flags
+	var contexts []context.Context
+	for _, option := range options {
+		switch option := option.(type) {
+		case Flags:
+			flags |= option
+		case contextOption:
+			contexts = append(contexts, option.ctx)
+		}
+	}
+	sub := Subscription{&subscription{disconnect: disconnect}}
+	connect(flags)
+	for _, ctx := range contexts {
+		sub.state.stop = append(sub.state.stop, context.AfterFunc(ctx, func() {
+			Callable.Defer(Callable.New(sub.Close))
+		}))
+	}
+	return sub
+}
+
+// Subscribe attaches the consumer to the signal (see [Any.Attach]) and returns a [Subscription]
+// that removes it again.
+//
+//	sub := signal.Subscribe(Callable.New(func() {}), Signal.OneShot, Signal.Context(ctx))
+//	defer sub.Close()
+func (signal *Any) Subscribe(consumer Callable.Function, options ...Option) Subscription {
+	return Subscribe(func(flags Flags) {
+		signal.Attach(consumer, flags)
+	}, func() {
+		signal.Remove(consumer)
+	}, options...)
+}
@@ -0,0 +1,47 @@
+package Signal_test
+
+import (
+	"context"
+	"testing"
+
+	"graphics.gd/variant/Callable"
+	"graphics.gd/variant/Signal"
+)
+
+func TestSubscribe(t *testing.T) {
+	var signal Signal.Any
+	var count int
+	sub := signal.Subscribe(Callable.New(func() { count++ }))
+	signal.Emit()
+	sub.Close()
+	sub.Close()
+	signal.Emit()
+	if count != 1 {
+		t.Fatalf("expected 1 call, got %d", count)
+	}
+	if signal.HasConnections() {
+		t.Fatal("expected Close to detach from the signal")
+	}
+}
+
+func TestSubscribeOneShot(t *testing.T) {
+	var signal Signal.Any
+	var count int
+	sub := signal.Subscribe(Callable.New(func() { count++ }), Signal.OneShot)
+	signal.Emit()
+	signal.Emit()
+	sub.Close()
+	if count != 1 {
+		t.Fatalf("expected 1 call, got %d", count)
+	}
+}
+
+func TestSubscribeContext(t *testing.T) {
+	var signal Signal.Any
+	ctx, cancel := context.WithCancel(context.Background())
+	signal.Subscribe(Callable.New(func() {}), Signal.Context(ctx))
+	cancel()
+	for signal.HasConnections() {
+		Callable.Cycle()
+	}
+}
@@ -0,0 +1,251 @@
+package Signal
+
+import (
+	"context"
+	"sync"
+
+	"graphics.gd/variant"
+	"graphics.gd/variant/Array"
+	"graphics.gd/variant/Callable"
+)
+
+// Block is called by [Any.Wait] to block until ready is closed. The startup package replaces it,
+// such that a main function that is being stepped by the engine (ie. with startup.Rendering)
+// keeps yielding frames to the engine while it waits, instead of blocking the engine.
+var Block = func(ready <-chan struct{}) { <-ready }
+
+// relay is a [Callable.Proxy] that queues the values of each emission, so that they can be
+// received from another goroutine without blocking the emitter.
+type relay struct {
+	mutex sync.Mutex
+	queue [][]variant.Any
+	wake  chan struct{}
+}
+
+func newRelay() *relay { return &relay{wake: make(chan struct{}, 1)} }
+
+func (r *relay) Name(complex128) string                                       { return "Signal.relay" }
+func (r *relay) Args(complex128) (int, Array.Any)                             { return 0, Array.Any{} }
+func (r *relay) Bind(complex128, ...variant.Any) (Callable.Proxy, complex128) { return r, 0 }
+func (r *relay) Call(_ complex128, args ...variant.Any) variant.Any {
+	r.mutex.Lock()
+	r.queue = append(r.queue, args)
+	r.mutex.Unlock()
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+	return variant.Nil
+}
+
+// next returns the next queued emission, if there is one.
+func (r *relay) next() ([]variant.Any, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(r.queue) == 0 {
+		return nil, false
+	}
+	args := r.queue[0]
+	r.queue = r.queue[1:]
+	return args, true
+}
+
+// Wait blocks until the signal is next emitted and returns the emitted values, or until the
+// context is done, in which case the context's error is returned. Wait attaches to the signal,
+// so it must be called from the main function when it is stepped by the engine, where it yields
+// frames to the engine until the signal is emitted. Anywhere else on the main thread, Wait would
+// block the engine from ever emitting the signal, so it panics instead.
+//
+//	for range startup.Rendering() {
+//		if _, err := player.Respawned.Wait(ctx); err != nil {
+//			return
+//		}
+//	}
+func (signal *Any) Wait(ctx context.Context) ([]variant.Any, error) {
+	r := newRelay()
+	fn := Callable.Through(r, 0)
+	if err := signal.Attach(fn, OneShot); err != nil {
+		return nil, err
+	}
+	ready := make(chan struct{})
+	stop := context.AfterFunc(ctx, func() { close(ready) })
+	go func() {
+		select {
+		case <-r.wake:
+			if stop() {
+				close(ready)
+			}
+		case <-ready:
+		}
+	}()
+	Block(ready)
+	if args, ok := r.next(); ok {
+		return args, nil
+	}
+	signal.Remove(fn)
+	return nil, ctx.Err()
+}
+
+// Chan returns a channel that receives the values of each emission of the signal, until
+// the context is done, at which point the channel is closed and detached from the signal.
+// Emissions are queued, such that a slow receiver never blocks the emitter. Chan must be
+// called from the main thread.
+func (signal *Any) Chan(ctx context.Context) <-chan []variant.Any {
+	r := newRelay()
+	fn := Callable.Through(r, 0)
+	out := make(chan []variant.Any)
+	if err := signal.Attach(fn); err != nil {
+		close(out)
+		return out
+	}
+	go func() {
+		defer close(out)
+		defer Callable.Defer(Callable.New(func() { signal.Remove(fn) }))
+		for {
+			args, ok := r.next()
+			if !ok {
+				select {
+				case <-r.wake:
+					continue
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case out <- args:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// arg returns the i'th value of args converted to T, or the zero value if there is no such value.
+func arg[T any](args []variant.Any, i int) T {
+	if i >= len(args) {
+		var zero T
+		return zero
+	}
+	return variant.As[T](args[i])
+}
+
+// convert relays each emission received from in, converted to T, until the context is done.
+func convert[T any](ctx context.Context, in <-chan []variant.Any, fn func([]variant.Any) T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for args := range in {
+			select {
+			case out <- fn(args):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Wait blocks until the signal is next emitted, see [Any.Wait].
+func (signal Void) Wait(ctx context.Context) error {
+	_, err := signal.Any.Wait(ctx)
+	return err
+}
+
+// Chan returns a channel that receives each emission of the signal, see [Any.Chan].
+func (signal Void) Chan(ctx context.Context) <-chan struct{} {
+	return convert(ctx, signal.Any.Chan(ctx), func([]variant.Any) struct{} { return struct{}{} })
+}
+
+// Wait blocks until the signal is next emitted and returns the emitted value, see [Any.Wait].
+func (signal Solo[A]) Wait(ctx context.Context) (A, error) {
+	args, err := signal.Any.Wait(ctx)
+	return arg[A](args, 0), err
+}
+
+// Chan returns a channel that receives each emitted value, see [Any.Chan].
+func (signal Solo[A]) Chan(ctx context.Context) <-chan A {
+	return convert(ctx, signal.Any.Chan(ctx), func(args []variant.Any) A { return arg[A](args, 0) })
+}
+
+// Wait blocks until the signal is next emitted and returns the emitted values, see [Any.Wait].
+func (signal Pair[A, B]) Wait(ctx context.Context) (A, B, error) {
+	args, err := signal.Any.Wait(ctx)
+	return arg[A](args, 0), arg[B](args, 1), err
+}
+
+// PairValues are the values of a [Pair] emission.
+type PairValues[A, B any] struct {
+	A A
+	B B
+}
+
+// Chan returns a channel that receives the values of each emission, see [Any.Chan].
+func (signal Pair[A, B]) Chan(ctx context.Context) <-chan PairValues[A, B] {
+	return convert(ctx, signal.Any.Chan(ctx), func(args []variant.Any) PairValues[A, B] {
+		return PairValues[A, B]{arg[A](args, 0), arg[B](args, 1)}
+	})
+}
+
+// Wait blocks until the signal is next emitted and returns the emitted values, see [Any.Wait].
+func (signal Trio[A, B, C]) Wait(ctx context.Context) (A, B, C, error) {
+	args, err := signal.Any.Wait(ctx)
+	return arg[A](args, 0), arg[B](args, 1), arg[C](args, 2), err
+}
+
+// TrioValues are the values of a [Trio] emission.
+type TrioValues[A, B, C any] struct {
+	A A
+	B B
+	C C
+}
+
+// Chan returns a channel that receives the values of each emission, see [Any.Chan].
+func (signal Trio[A, B, C]) Chan(ctx context.Context) <-chan TrioValues[A, B, C] {
+	return convert(ctx, signal.Any.Chan(ctx), func(args []variant.Any) TrioValues[A, B, C] {
+		return TrioValues[A, B, C]{arg[A](args, 0), arg[B](args, 1), arg[C](args, 2)}
+	})
+}
+
+// Wait blocks until the signal is next emitted and returns the emitted values, see [Any.Wait].
+func (signal Quad[A, B, C, D]) Wait(ctx context.Context) (A, B, C, D, error) {
+	args, err := signal.Any.Wait(ctx)
+	return arg[A](args, 0), arg[B](args, 1), arg[C](args, 2), arg[D](args, 3), err
+}
+
+// QuadValues are the values of a [Quad] emission.
+type QuadValues[A, B, C, D any] struct {
+	A A
+	B B
+	C C
+	D D
+}
+
+// Chan returns a channel that receives the values of each emission, see [Any.Chan].
+func (signal Quad[A, B, C, D]) Chan(ctx context.Context) <-chan QuadValues[A, B, C, D] {
+	return convert(ctx, signal.Any.Chan(ctx), func(args []variant.Any) QuadValues[A, B, C, D] {
+		return QuadValues[A, B, C, D]{arg[A](args, 0), arg[B](args, 1), arg[C](args, 2), arg[D](args, 3)}
+	})
+}
+
+// Wait blocks until the signal is next emitted and returns the emitted values, see [Any.Wait].
+func (signal Quin[A, B, C, D, E]) Wait(ctx context.Context) (A, B, C, D, E, error) {
+	args, err := signal.Any.Wait(ctx)
+	return arg[A](args, 0), arg[B](args, 1), arg[C](args, 2), arg[D](args, 3), arg[E](args, 4), err
+}
+
+// QuinValues are the values of a [Quin] emission.
+type QuinValues[A, B, C, D, E any] struct {
+	A A
+	B B
+	C C
+	D D
+	E E
+}
+
+// Chan returns a channel that receives the values of each emission, see [Any.Chan].
+func (signal Quin[A, B, C, D, E]) Chan(ctx context.Context) <-chan QuinValues[A, B, C, D, E] {
+	return convert(ctx, signal.Any.Chan(ctx), func(args []variant.Any) QuinValues[A, B, C, D, E] {
+		return QuinValues[A, B, C, D, E]{arg[A](args, 0), arg[B](args, 1), arg[C](args, 2), arg[D](args, 3), arg[E](args, 4)}
+	})
+}
@@ -0,0 +1,95 @@
+package Signal_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"graphics.gd/variant"
+	"graphics.gd/variant/Callable"
+	"graphics.gd/variant/Signal"
+)
+
+func TestWait(t *testing.T) {
+	var signal Signal.Any
+	signal.Attach(Callable.New(func(int) {})) // initialize the signal before emitting from another goroutine.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	go func() {
+		for ctx.Err() == nil {
+			signal.Emit(variant.New(42))
+			time.Sleep(time.Millisecond)
+		}
+	}()
+	args, err := signal.Wait(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(args) != 1 || variant.As[int](args[0]) != 42 {
+		t.Fatalf("expected [42], got %v", args)
+	}
+}
+
+func TestWaitCancel(t *testing.T) {
+	var signal Signal.Any
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := signal.Wait(ctx); err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if signal.HasConnections() {
+		t.Fatal("expected Wait to detach from the signal")
+	}
+}
+
+func TestChan(t *testing.T) {
+	var signal Signal.Any
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := signal.Chan(ctx)
+	for i := range 3 {
+		signal.Emit(variant.New(i))
+	}
+	for i := range 3 {
+		if args := <-ch; variant.As[int](args[0]) != i {
+			t.Fatalf("expected %d, got %v", i, args)
+		}
+	}
+	cancel()
+	if _, ok := <-ch; ok {
+		t.Fatal("expected channel to be closed")
+	}
+}
+
+func TestPairChan(t *testing.T) {
+	var signal Signal.Pair[string, int]
+	signal.Any.Attach(Callable.New(func(string, int) {})) // initialize the signal, as registered signals are.
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := signal.Chan(ctx)
+	signal.Any.Emit(variant.New("score"), variant.New(3))
+	if values := <-ch; values.A != "score" || values.B != 3 {
+		t.Fatalf("expected {score 3}, got %v", values)
+	}
+	cancel()
+	if _, ok := <-ch; ok {
+		t.Fatal("expected channel to be closed")
+	}
+}
+
+func TestSoloChanCancelUnread(t *testing.T) {
+	var signal Signal.Solo[int]
+	signal.Any.Attach(Callable.New(func(int) {})) // initialize the signal, as registered signals are.
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	signal.Chan(ctx)
+	signal.Any.Emit(variant.New(1))
+	time.Sleep(10 * time.Millisecond) // let the emission be relayed, up to the unread channel.
+	cancel()
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the relay goroutines to exit once the context was cancelled, even though nothing was received")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}